@@ -0,0 +1,317 @@
+// Package cabicall defines an Analyzer that checks cabi.Call sites against C
+// function prototypes declared next to the trampolines.
+//
+// Trampolines generated by zgen are annotated with C prototypes, e.g.
+//
+//	// CFIndex CFDataGetLength(CFDataRef theData)
+//	var extern_CFDataGetLength_trampolineABI0 uintptr
+//
+// and weak-linked functions have the prototype in the doc comment of the
+// extern_<name>_getAddr function. The analyzer uses these annotations to
+// verify the number of arguments, argument and result constructors of each
+// cabi.Call, and that wrapper functions call the trampoline they are named
+// after.
+package cabicall
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/noncgo/x/darwin/internal/zgen"
+)
+
+const doc = `check cabi.Call sites against declared C prototypes
+
+The cabicall analyzer reads C prototypes from comments on extern_*_trampolineABI0
+variables and extern_*_getAddr functions, and reports cabi.Call invocations
+with the wrong number of arguments, arguments or output that do not match the
+C types, and wrappers, e.g. cfDataGetLength, that call a trampoline for a
+different C function.`
+
+// Analyzer is the cabi.Call prototype analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name: "cabicall",
+	Doc:  doc,
+	URL:  "https://pkg.go.dev/github.com/noncgo/x/darwin/analysis/cabicall",
+	Run:  run,
+}
+
+// cabiPath is the import path of the package with Call function.
+const cabiPath = "github.com/noncgo/x/darwin/internal/cabi"
+
+const (
+	trampolinePrefix = "extern_"
+	trampolineSuffix = "_trampolineABI0"
+	getAddrSuffix    = "_getAddr"
+)
+
+// pointerArgs are cabi Arg constructors for pointer-sized arguments that are
+// interchangeable at the ABI level.
+var pointerArgs = map[string]bool{
+	"Uintptr":       true,
+	"UnsafePointer": true,
+	"Bytes":         true,
+	"String":        true,
+}
+
+// symbol is a C function with a trampoline in the package.
+type symbol struct {
+	name  string
+	proto *zgen.Proto // nil if the trampoline is not annotated
+}
+
+type checker struct {
+	pass *analysis.Pass
+	// symbols maps C function names to symbols.
+	symbols map[string]*symbol
+	// wrappers maps wrapper function names to symbols.
+	wrappers map[string]*symbol
+	// addrVars maps local variables to symbols for weak-linked functions,
+	// e.g. fn := extern_CFFoo_getAddr().
+	addrVars map[types.Object]*symbol
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if !importsCabi(pass.Pkg) {
+		return nil, nil
+	}
+	c := &checker{
+		pass:     pass,
+		symbols:  make(map[string]*symbol),
+		wrappers: make(map[string]*symbol),
+		addrVars: make(map[types.Object]*symbol),
+	}
+	for _, f := range pass.Files {
+		c.collect(f)
+	}
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok && c.cabiFunc(call) == "Call" {
+					c.check(fn, call)
+				}
+				return true
+			})
+		}
+	}
+	return nil, nil
+}
+
+func importsCabi(pkg *types.Package) bool {
+	for _, p := range pkg.Imports() {
+		if p.Path() == cabiPath {
+			return true
+		}
+	}
+	return false
+}
+
+// collect records annotated trampolines and weak-linked function addresses.
+func (c *checker) collect(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				spec, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				doc := spec.Doc
+				if doc == nil && len(decl.Specs) == 1 {
+					doc = decl.Doc
+				}
+				for _, id := range spec.Names {
+					if name, ok := cutAffixes(id.Name, trampolineSuffix); ok {
+						c.addSymbol(name, protoFromDoc(doc, false))
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Recv != nil {
+				continue
+			}
+			if name, ok := cutAffixes(decl.Name.Name, getAddrSuffix); ok {
+				c.addSymbol(name, protoFromDoc(decl.Doc, true))
+			}
+		}
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+				return true
+			}
+			id, ok := assign.Lhs[0].(*ast.Ident)
+			if !ok {
+				return true
+			}
+			call, ok := assign.Rhs[0].(*ast.CallExpr)
+			if !ok || len(call.Args) != 0 {
+				return true
+			}
+			fun, ok := call.Fun.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			name, ok := cutAffixes(fun.Name, getAddrSuffix)
+			if !ok {
+				return true
+			}
+			if obj := c.pass.TypesInfo.ObjectOf(id); obj != nil {
+				c.addrVars[obj] = c.addSymbol(name, nil)
+			}
+			return true
+		})
+	}
+}
+
+// addSymbol adds the symbol with the given name or updates its prototype.
+func (c *checker) addSymbol(name string, p *zgen.Proto) *symbol {
+	s, ok := c.symbols[name]
+	if !ok {
+		s = &symbol{name: name}
+		c.symbols[name] = s
+		c.wrappers[zgen.GoFuncName(name)] = s
+	}
+	if p != nil && p.Name == name {
+		s.proto = p
+	}
+	return s
+}
+
+// cutAffixes returns the C function name from the extern_<name><suffix>
+// identifier.
+func cutAffixes(s, suffix string) (string, bool) {
+	if !strings.HasPrefix(s, trampolinePrefix) || !strings.HasSuffix(s, suffix) {
+		return "", false
+	}
+	s = s[len(trampolinePrefix) : len(s)-len(suffix)]
+	return s, s != ""
+}
+
+// protoFromDoc parses the C prototype from the doc comment. If indented is
+// true, the prototype is an indented line in the comment, otherwise the
+// comment consists of the prototype only.
+func protoFromDoc(doc *ast.CommentGroup, indented bool) *zgen.Proto {
+	if doc == nil {
+		return nil
+	}
+	text := doc.Text()
+	if !indented {
+		text = strings.TrimSpace(text)
+		if !zgen.IsProto(text) {
+			return nil
+		}
+		p, _ := zgen.ParseProto(text)
+		return p
+	}
+	for _, line := range strings.Split(text, "\n") {
+		if !strings.HasPrefix(line, "\t") {
+			continue
+		}
+		line = strings.TrimSpace(line)
+		if zgen.IsProto(line) {
+			if p, err := zgen.ParseProto(line); err == nil {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+// cabiFunc returns the name of the called cabi package function or an empty
+// string if the call is nil or not a cabi function call.
+func (c *checker) cabiFunc(call *ast.CallExpr) string {
+	if call == nil {
+		return ""
+	}
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	fn, ok := c.pass.TypesInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != cabiPath {
+		return ""
+	}
+	return fn.Name()
+}
+
+// target returns the symbol for the function address argument of cabi.Call.
+func (c *checker) target(e ast.Expr) *symbol {
+	id, ok := ast.Unparen(e).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	if name, ok := cutAffixes(id.Name, trampolineSuffix); ok {
+		return c.symbols[name]
+	}
+	return c.addrVars[c.pass.TypesInfo.ObjectOf(id)]
+}
+
+// check checks the cabi.Call in the function.
+func (c *checker) check(fn *ast.FuncDecl, call *ast.CallExpr) {
+	if len(call.Args) < 2 {
+		return
+	}
+	s := c.target(call.Args[0])
+	if s == nil {
+		return
+	}
+	if w, ok := c.wrappers[fn.Name.Name]; ok && w != s {
+		c.pass.Reportf(call.Args[0].Pos(), "%s calls %s instead of %s", fn.Name.Name, s.name, w.name)
+	}
+	p := s.proto
+	if p == nil {
+		return
+	}
+	out, args, ok := callSignature(p)
+	if !ok {
+		// The prototype uses types that are not known to the analyzer,
+		// e.g. types that are only declared in SDK headers.
+		return
+	}
+
+	if got := c.cabiFunc(argCall(call.Args[1])); got != "" && got != out {
+		c.pass.Reportf(call.Args[1].Pos(), "%s returns %s: got cabi.%s, want cabi.%s", s.name, p.Result, got, out)
+	}
+
+	if call.Ellipsis.IsValid() {
+		return
+	}
+	callArgs := call.Args[2:]
+	if len(callArgs) != len(args) {
+		c.pass.Reportf(call.Pos(), "%s takes %d arguments, got %d", s.name, len(args), len(callArgs))
+		return
+	}
+	for i, arg := range callArgs {
+		got := c.cabiFunc(argCall(arg))
+		want := args[i]
+		if got == "" || got == want || pointerArgs[got] && pointerArgs[want] {
+			continue
+		}
+		if len(args) != len(p.Params) {
+			// Structures passed by value span several arguments.
+			c.pass.Reportf(arg.Pos(), "argument %d of %s: got cabi.%s, want cabi.%s", i+1, s.name, got, want)
+			continue
+		}
+		param := strings.TrimSpace(p.Params[i].Type.String() + " " + p.Params[i].Name)
+		c.pass.Reportf(arg.Pos(), "argument %d (%s) of %s: got cabi.%s, want cabi.%s", i+1, param, s.name, got, want)
+	}
+}
+
+// argCall returns the call expression or nil if e is not a call.
+func argCall(e ast.Expr) *ast.CallExpr {
+	call, _ := ast.Unparen(e).(*ast.CallExpr)
+	return call
+}
@@ -0,0 +1,13 @@
+package cabicall_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/noncgo/x/darwin/analysis/cabicall"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), cabicall.Analyzer, "github.com/noncgo/x/darwin/a")
+}
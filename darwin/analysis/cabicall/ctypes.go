@@ -0,0 +1,146 @@
+package cabicall
+
+import (
+	"strings"
+
+	"github.com/noncgo/x/darwin/internal/zgen"
+)
+
+// The analyzer maps C types to cabi constructors on its own rather than using
+// zgen type mappings, so that generated wrappers are checked against an
+// independent description of the C ABI. The tables are derived from C and SDK
+// headers for LP64 data model and only need to cover types that the checked
+// packages use. Prototypes with unknown types are not checked.
+
+// scalarTypes maps C scalar types to cabi Arg constructors.
+var scalarTypes = map[string]string{
+	// C
+	"_Bool":              "Bool",
+	"bool":               "Bool",
+	"char":               "Int8",
+	"signed char":        "Int8",
+	"unsigned char":      "Uint8",
+	"short":              "Int16",
+	"unsigned short":     "Uint16",
+	"int":                "Int32",
+	"unsigned":           "Uint32",
+	"unsigned int":       "Uint32",
+	"long":               "Int",
+	"unsigned long":      "Uint",
+	"long long":          "Int64",
+	"unsigned long long": "Uint64",
+	"float":              "Float32",
+	"double":             "Float64",
+	"int8_t":             "Int8",
+	"int16_t":            "Int16",
+	"int32_t":            "Int32",
+	"int64_t":            "Int64",
+	"uint8_t":            "Uint8",
+	"uint16_t":           "Uint16",
+	"uint32_t":           "Uint32",
+	"uint64_t":           "Uint64",
+	"intptr_t":           "Int",
+	"uintptr_t":          "Uintptr",
+	"ssize_t":            "Int",
+	"size_t":             "Uint",
+
+	// MacTypes.h
+	"Boolean":  "Bool",
+	"SInt8":    "Int8",
+	"SInt16":   "Int16",
+	"SInt32":   "Int32",
+	"SInt64":   "Int64",
+	"UInt8":    "Uint8",
+	"UInt16":   "Uint16",
+	"UInt32":   "Uint32",
+	"UInt64":   "Uint64",
+	"OSStatus": "Int32",
+
+	// pthread.h
+	"pthread_t": "Uintptr", // struct _opaque_pthread_t *
+
+	// CFBase.h and other Core Foundation headers
+	"CFIndex":              "Int",     // signed long
+	"CFTypeID":             "Uint",    // unsigned long
+	"CFHashCode":           "Uint",    // unsigned long
+	"CFOptionFlags":        "Uint",    // unsigned long
+	"CFComparisonResult":   "Int",     // CF_ENUM(CFIndex)
+	"CFStringEncoding":     "Uint32",  // UInt32
+	"CFStringCompareFlags": "Uint",    // CF_OPTIONS(CFOptionFlags)
+	"CFNumberType":         "Int",     // CF_ENUM(CFIndex)
+	"CFPropertyListFormat": "Int",     // CF_ENUM(CFIndex)
+	"CFTimeInterval":       "Float64", // double
+	"CFAbsoluteTime":       "Float64", // CFTimeInterval
+	"CFRunLoopRunResult":   "Int32",   // CF_ENUM(SInt32)
+
+	// FSEvents.h
+	"FSEventStreamEventId":     "Uint64", // UInt64
+	"FSEventStreamCreateFlags": "Uint32", // UInt32
+	"FSEventStreamEventFlags":  "Uint32", // UInt32
+}
+
+// referenceTypes are typedefs for object references that do not follow the
+// “Ref” naming convention.
+var referenceTypes = map[string]bool{
+	"CFErrorDomain":       true, // CFStringRef
+	"CFRunLoopMode":       true, // CFStringRef
+	"CFStreamPropertyKey": true, // CFStringRef
+}
+
+// structTypes maps C structures that are passed by value to cabi Arg
+// constructors for their fields.
+var structTypes = map[string][]string{
+	"CFRange": {"Int", "Int"}, // CFIndex location, length
+}
+
+// argTypes returns cabi Arg constructors for the C type. Structures passed by
+// value span several arguments. It returns false if the type is unknown.
+func argTypes(t zgen.Type) ([]string, bool) {
+	switch {
+	case t.Pointers > 0:
+		return []string{"UnsafePointer"}, true
+	case isReference(t.Name):
+		return []string{"Uintptr"}, true
+	}
+	if s, ok := scalarTypes[t.Name]; ok {
+		return []string{s}, true
+	}
+	if fields, ok := structTypes[t.Name]; ok {
+		return fields, true
+	}
+	return nil, false
+}
+
+// isReference returns true if the type name is an object reference or
+// a function pointer typedef, i.e. a pointer-sized opaque value.
+func isReference(name string) bool {
+	return strings.HasSuffix(name, "Ref") ||
+		strings.HasSuffix(name, "CallBack") ||
+		strings.HasSuffix(name, "Callback") ||
+		referenceTypes[name]
+}
+
+// callSignature returns cabi constructors for the result and the arguments of
+// the C function. It returns false if the prototype uses unknown types.
+func callSignature(p *zgen.Proto) (out string, args []string, ok bool) {
+	switch t := p.Result; {
+	case t.IsVoid():
+		out = "Void"
+	case t.Pointers > 0 || isReference(t.Name):
+		out = "OutUintptr"
+	default:
+		s, ok := scalarTypes[t.Name]
+		if !ok {
+			return "", nil, false
+		}
+		out = "Out" + s
+	}
+	for _, param := range p.Params {
+		xs, ok := argTypes(param.Type)
+		if !ok {
+			return "", nil, false
+		}
+		args = append(args, xs...)
+	}
+	return out, args, true
+}
@@ -0,0 +1,98 @@
+package a
+
+import (
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/cabi"
+)
+
+func cfDataGetLength(theData uintptr) int {
+	var out int
+	cabi.Call(
+		extern_CFDataGetLength_trampolineABI0,
+		cabi.OutInt(&out),
+		cabi.Uintptr(theData),
+	)
+	return out
+}
+
+func cfRelease(cf uintptr) {
+	cabi.Call(
+		extern_CFDataGetLength_trampolineABI0, // want `cfRelease calls CFDataGetLength instead of CFRelease`
+		cabi.Void(),                           // want `CFDataGetLength returns CFIndex: got cabi.Void, want cabi.OutInt`
+		cabi.Uintptr(cf),
+	)
+}
+
+func cfStringCreateWithBytes(alloc uintptr, bytes []byte, enc uint32, ext bool) uintptr {
+	var out uintptr
+	cabi.Call(
+		extern_CFStringCreateWithBytes_trampolineABI0,
+		cabi.OutUintptr(&out),
+		cabi.Uintptr(alloc),
+		cabi.UnsafePointer(unsafe.Pointer(&bytes[0])),
+		cabi.Int(len(bytes)),
+		cabi.Int(int(enc)), // want `argument 4 \(CFStringEncoding encoding\) of CFStringCreateWithBytes: got cabi.Int, want cabi.Uint32`
+		cabi.Bool(ext),
+	)
+	return out
+}
+
+func cfRunLoopRunInMode(mode uintptr, seconds float64) int32 {
+	var out uint32
+	cabi.Call( // want `CFRunLoopRunInMode takes 3 arguments, got 2`
+		extern_CFRunLoopRunInMode_trampolineABI0,
+		cabi.OutUint32(&out), // want `CFRunLoopRunInMode returns CFRunLoopRunResult: got cabi.OutUint32, want cabi.OutInt32`
+		cabi.Uintptr(mode),
+		cabi.Float64(seconds),
+	)
+	return int32(out)
+}
+
+func releaseVoid(cf uintptr) {
+	cabi.Call(
+		extern_CFRelease_trampolineABI0,
+		cabi.OutUintptr(nil), // want `CFRelease returns void: got cabi.OutUintptr, want cabi.Void`
+		cabi.Uintptr(cf),
+	)
+}
+
+func cfArrayContainsValue(theArray uintptr, rangeLocation, rangeLength int, value uintptr) bool {
+	var out bool
+	cabi.Call(
+		extern_CFArrayContainsValue_trampolineABI0,
+		cabi.OutBool(&out),
+		cabi.Uintptr(theArray),
+		cabi.Int(rangeLocation),
+		cabi.Int32(int32(rangeLength)), // want `argument 3 of CFArrayContainsValue: got cabi.Int32, want cabi.Int`
+		cabi.Uintptr(value),
+	)
+	return out
+}
+
+// Prototypes with types that are only declared in SDK headers and trampolines
+// without prototypes are not checked. The fixture uses made-up types that no
+// binding can declare.
+func unchecked(cf uintptr) {
+	cabi.Call(extern_ExampleCreate_trampolineABI0, cabi.Void())
+	cabi.Call(extern_CFShow_trampolineABI0, cabi.Void(), cabi.Int(0), cabi.Int(0))
+}
+
+func variadic(args []cabi.Arg) {
+	cabi.Call(extern_CFRelease_trampolineABI0, cabi.Void(), args...)
+}
+
+func fsEventStreamSetExclusionPaths(streamRef, pathsToExclude uintptr) bool {
+	fn := extern_FSEventStreamSetExclusionPaths_getAddr()
+	if fn == 0 {
+		return false
+	}
+	var out bool
+	cabi.Call(
+		fn,
+		cabi.OutBool(&out),
+		cabi.Uintptr(streamRef),
+		cabi.Int32(0), // want `argument 2 \(CFArrayRef pathsToExclude\) of FSEventStreamSetExclusionPaths: got cabi.Int32, want cabi.Uintptr`
+	)
+	return out
+}
@@ -0,0 +1,26 @@
+package a
+
+// CFIndex CFDataGetLength(CFDataRef theData)
+var extern_CFDataGetLength_trampolineABI0 uintptr
+
+// void CFRelease(CFTypeRef cf)
+var extern_CFRelease_trampolineABI0 uintptr
+
+// CFStringRef CFStringCreateWithBytes(CFAllocatorRef alloc, const UInt8 *bytes, CFIndex numBytes, CFStringEncoding encoding, Boolean isExternalRepresentation)
+var extern_CFStringCreateWithBytes_trampolineABI0 uintptr
+
+// CFRunLoopRunResult CFRunLoopRunInMode(CFRunLoopMode mode, CFTimeInterval seconds, Boolean returnAfterSourceHandled)
+var extern_CFRunLoopRunInMode_trampolineABI0 uintptr
+
+// Boolean CFArrayContainsValue(CFArrayRef theArray, CFRange range, const void *value)
+var extern_CFArrayContainsValue_trampolineABI0 uintptr
+
+// ExampleRef ExampleCreate(CFAllocatorRef allocator, ExampleKind kind)
+var extern_ExampleCreate_trampolineABI0 uintptr
+
+var extern_CFShow_trampolineABI0 uintptr
+
+// extern_FSEventStreamSetExclusionPaths_getAddr returns the function address or zero if it is not available.
+//
+//	Boolean FSEventStreamSetExclusionPaths(FSEventStreamRef streamRef, CFArrayRef pathsToExclude)
+func extern_FSEventStreamSetExclusionPaths_getAddr() uintptr { return 0 }
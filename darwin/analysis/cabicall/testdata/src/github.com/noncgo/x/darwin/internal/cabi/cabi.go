@@ -0,0 +1,26 @@
+// Package cabi is a stub of the C ABI package for tests.
+package cabi
+
+import "unsafe"
+
+type Arg struct{}
+
+type Out struct{}
+
+func Call(fn uintptr, out Out, args ...Arg) {}
+
+func UnsafePointer(v unsafe.Pointer) Arg { return Arg{} }
+func Bytes(v []byte) Arg                 { return Arg{} }
+func Uintptr(v uintptr) Arg              { return Arg{} }
+func Bool(v bool) Arg                    { return Arg{} }
+func Int(v int) Arg                      { return Arg{} }
+func Int32(v int32) Arg                  { return Arg{} }
+func Uint(v uint) Arg                    { return Arg{} }
+func Uint32(v uint32) Arg                { return Arg{} }
+func Float64(v float64) Arg              { return Arg{} }
+
+func Void() Out                 { return Out{} }
+func OutUintptr(p *uintptr) Out { return Out{} }
+func OutBool(p *bool) Out       { return Out{} }
+func OutInt(p *int) Out         { return Out{} }
+func OutUint32(p *uint32) Out   { return Out{} }
@@ -0,0 +1,21 @@
+// The cfcheck command runs static analyzers for code that uses
+// github.com/noncgo/x/darwin bindings.
+//
+// Usage:
+//
+//	GOOS=darwin cfcheck ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/multichecker"
+
+	"github.com/noncgo/x/darwin/analysis/cabicall"
+	"github.com/noncgo/x/darwin/analysis/ownership"
+)
+
+func main() {
+	multichecker.Main(
+		cabicall.Analyzer,
+		ownership.Analyzer,
+	)
+}
@@ -0,0 +1,17 @@
+module github.com/noncgo/x/darwin/analysis
+
+go 1.22.0
+
+require (
+	github.com/noncgo/x/darwin v0.0.0-00010101000000-000000000000
+	golang.org/x/tools v0.26.0
+)
+
+require (
+	golang.org/x/mod v0.21.0 // indirect
+	golang.org/x/sync v0.8.0 // indirect
+)
+
+// Analyzers use the C prototype parser of the code generator in the parent
+// module.
+replace github.com/noncgo/x/darwin => ../
@@ -0,0 +1,769 @@
+// Package ownership defines an Analyzer that checks Core Foundation ownership
+// rules in code that uses github.com/noncgo/x/darwin bindings.
+//
+// Core Foundation functions follow the naming convention where functions with
+// Create or Copy in their name return a reference that the caller owns and
+// must release, and functions with Get in their name return a borrowed
+// reference that the caller must not release unless it was retained. Go
+// constructors with New prefix, e.g. corefoundation.NewString, follow the
+// Create rule.
+//
+// Functions whose names do not follow the convention declare the ownership of
+// the result in their doc comments. The sentence “The caller owns the returned
+// reference.” marks an owned reference, e.g. corefoundation.FromGo, and
+// a sentence with “retains the ownership”, e.g. “The array retains the
+// ownership of the returned value.”, marks a borrowed reference or a slice of
+// borrowed references, e.g. corefoundation.ArrayValues. Generated wrappers of
+// functions annotated with CF_RETURNS_RETAINED in SDK headers use the former.
+// Doc comments take precedence over names.
+//
+// References
+//   - https://developer.apple.com/library/archive/documentation/CoreFoundation/Conceptual/CFMemoryMgmt/Concepts/Ownership.html
+package ownership
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/ctrlflow"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/cfg"
+)
+
+const doc = `check Core Foundation Create/Copy ownership rules
+
+The ownership analyzer tracks references returned by Create, Copy and New
+functions from github.com/noncgo/x/darwin packages through a function and reports
+references that are not released on some return path, references that are
+released twice, and borrowed references returned by Get functions that are
+released without being retained first. Doc comments that say “The caller
+owns” or “retains the ownership” override the naming convention.
+
+A reference stops being tracked once it escapes the function, e.g. when it is
+returned, stored in a variable or a composite literal, captured by a closure or
+passed to a function outside of the bindings.`
+
+// Analyzer is the Core Foundation ownership analyzer.
+var Analyzer = &analysis.Analyzer{
+	Name:      "cfownership",
+	Doc:       doc,
+	URL:       "https://pkg.go.dev/github.com/noncgo/x/darwin/analysis/ownership",
+	Requires:  []*analysis.Analyzer{inspect.Analyzer, ctrlflow.Analyzer},
+	Run:       run,
+	FactTypes: []analysis.Fact{new(ownershipFact)},
+}
+
+// bindingsPrefix is the import path prefix of packages with Core Foundation
+// bindings.
+const bindingsPrefix = "github.com/noncgo/x/darwin/"
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	cfgs := pass.ResultOf[ctrlflow.Analyzer].(*ctrlflow.CFGs)
+
+	if strings.HasPrefix(pass.Pkg.Path()+"/", bindingsPrefix) {
+		exportFacts(pass)
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+		(*ast.FuncLit)(nil),
+	}
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var g *cfg.CFG
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			if n.Body == nil {
+				return
+			}
+			g = cfgs.FuncDecl(n)
+		case *ast.FuncLit:
+			g = cfgs.FuncLit(n)
+		}
+		if g == nil {
+			return
+		}
+		c := &checker{
+			pass:      pass,
+			reported:  make(map[report]bool),
+			discarded: make(map[*ast.CallExpr]types.Object),
+			ranges:    make(map[ast.Expr]bool),
+		}
+		ast.Inspect(n, func(n ast.Node) bool {
+			if r, ok := n.(*ast.RangeStmt); ok {
+				c.ranges[r.X] = true
+			}
+			return true
+		})
+		c.check(g)
+	})
+	return nil, nil
+}
+
+// callKind is the ownership semantics of a function call.
+type callKind int
+
+const (
+	callOther   callKind = iota // function outside of the bindings
+	callNeutral                 // binding that does not affect ownership
+	callCreate                  // returns an owned reference
+	callGet                     // returns a borrowed reference
+	callRetain                  // retains its argument
+	callRelease                 // releases its argument
+)
+
+// ownershipFact is the ownership semantics of a binding function that is
+// declared in its doc comment.
+type ownershipFact struct {
+	Kind callKind // callCreate or callGet
+}
+
+func (*ownershipFact) AFact() {}
+
+func (f *ownershipFact) String() string {
+	if f.Kind == callCreate {
+		return "owned"
+	}
+	return "borrowed"
+}
+
+// exportFacts exports ownership facts for functions with doc comments that
+// declare the ownership of the result.
+func exportFacts(pass *analysis.Pass) {
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.FuncDecl)
+			if !ok || decl.Recv != nil || decl.Doc == nil {
+				continue
+			}
+			fn, ok := pass.TypesInfo.Defs[decl.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			if kind := docOwnership(decl.Doc.Text()); kind != callOther {
+				pass.ExportObjectFact(fn, &ownershipFact{Kind: kind})
+			}
+		}
+	}
+}
+
+// docOwnership returns callCreate or callGet if the doc comment declares that
+// the result is owned or borrowed respectively, and callOther otherwise.
+func docOwnership(doc string) callKind {
+	doc = strings.Join(strings.Fields(doc), " ")
+	switch {
+	case strings.Contains(doc, "The caller owns the returned"):
+		return callCreate
+	case strings.Contains(doc, "retains the ownership of the returned"):
+		return callGet
+	}
+	return callOther
+}
+
+// classify returns the ownership semantics of the call and the callee.
+func classify(pass *analysis.Pass, call *ast.CallExpr) (callKind, *types.Func) {
+	info := pass.TypesInfo
+	var id *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
+		return callOther, nil
+	}
+	fn, ok := info.Uses[id].(*types.Func)
+	if !ok || fn.Pkg() == nil || !strings.HasPrefix(fn.Pkg().Path()+"/", bindingsPrefix) {
+		return callOther, nil
+	}
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() != nil {
+		return callNeutral, fn
+	}
+	var fact ownershipFact
+	if pass.ImportObjectFact(fn, &fact) {
+		switch {
+		case returnsReference(sig):
+			return fact.Kind, fn
+		case fact.Kind == callGet && returnsReferences(sig):
+			// Elements of the slice are borrowed.
+			return callGet, fn
+		}
+		return callNeutral, fn
+	}
+	name := fn.Name()
+	switch {
+	case strings.HasPrefix(name, "Release") && sig.Params().Len() == 1:
+		return callRelease, fn
+	case strings.HasPrefix(name, "Retain") && sig.Params().Len() == 1:
+		return callRetain, fn
+	case !returnsReference(sig):
+		return callNeutral, fn
+	case strings.Contains(name, "Create") || strings.Contains(name, "Copy") || strings.HasPrefix(name, "New"):
+		return callCreate, fn
+	case strings.Contains(name, "Get"):
+		return callGet, fn
+	}
+	return callNeutral, fn
+}
+
+// returnsReference reports whether the first result of the function is an
+// opaque reference, i.e. a non-error interface type.
+func returnsReference(sig *types.Signature) bool {
+	if sig.Results().Len() == 0 {
+		return false
+	}
+	t := sig.Results().At(0).Type()
+	return types.IsInterface(t) && !isError(t)
+}
+
+// returnsReferences reports whether the first result of the function is
+// a slice of opaque references.
+func returnsReferences(sig *types.Signature) bool {
+	if sig.Results().Len() == 0 {
+		return false
+	}
+	s, ok := sig.Results().At(0).Type().Underlying().(*types.Slice)
+	return ok && types.IsInterface(s.Elem()) && !isError(s.Elem())
+}
+
+// isError reports whether t is the error type.
+func isError(t types.Type) bool {
+	return types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// refState is a set of possible states of a tracked reference on the paths
+// that reach a program point.
+type refState uint8
+
+const (
+	mayOwned refState = 1 << iota
+	mayReleased
+	borrowed
+	deferred // released by a deferred call
+)
+
+// ref is a tracked reference.
+type ref struct {
+	state  refState
+	origin string       // name of the function that returned the reference
+	ok     types.Object // result that reports whether the reference is valid
+	err    types.Object // error result that is not nil if the reference is invalid
+	// discarded is true if the reference is not assigned to a variable but
+	// its ok result is, e.g. _, ok := CreateX().
+	discarded bool
+	// elems is true if the variable is a slice of borrowed references.
+	elems bool
+}
+
+// facts maps local variables to tracked references.
+type facts map[types.Object]ref
+
+func (f facts) clone() facts {
+	r := make(facts, len(f))
+	for k, v := range f {
+		r[k] = v
+	}
+	return r
+}
+
+// merge merges o into f and reports whether f changed.
+func (f facts) merge(o facts) bool {
+	changed := false
+	for k, v := range o {
+		old, ok := f[k]
+		if !ok {
+			f[k] = v
+			changed = true
+			continue
+		}
+		if old.state|v.state != old.state {
+			old.state |= v.state
+			f[k] = old
+			changed = true
+		}
+	}
+	return changed
+}
+
+// report identifies a diagnostic to avoid duplicates when the same node is
+// visited on multiple paths.
+type report struct {
+	pos token.Pos
+	obj types.Object
+}
+
+type checker struct {
+	pass     *analysis.Pass
+	reported map[report]bool
+	// discarded maps calls with discarded results to placeholder variables
+	// that track these results.
+	discarded map[*ast.CallExpr]types.Object
+	// silent disables diagnostics while the analysis has not converged.
+	silent bool
+	// ranges are range expressions of range statements in the function.
+	ranges map[ast.Expr]bool
+}
+
+func (c *checker) reportf(pos token.Pos, obj types.Object, format string, args ...interface{}) {
+	if c.silent {
+		return
+	}
+	k := report{pos, obj}
+	if c.reported[k] {
+		return
+	}
+	c.reported[k] = true
+	c.pass.Reportf(pos, format, args...)
+}
+
+// check runs the forward data flow analysis over the control flow graph until
+// it converges and then reports diagnostics in one final pass.
+func (c *checker) check(g *cfg.CFG) {
+	if len(g.Blocks) == 0 {
+		return
+	}
+	in := make([]facts, len(g.Blocks))
+	in[0] = make(facts)
+	c.silent = true
+	for changed := true; changed; {
+		changed = false
+		for _, b := range g.Blocks {
+			if !b.Live || in[b.Index] == nil {
+				continue
+			}
+			for i, out := range c.transfer(b, in[b.Index].clone()) {
+				succ := b.Succs[i]
+				if in[succ.Index] == nil {
+					in[succ.Index] = make(facts)
+					changed = true
+				}
+				if in[succ.Index].merge(out) {
+					changed = true
+				}
+			}
+		}
+	}
+	c.silent = false
+	for _, b := range g.Blocks {
+		if b.Live && in[b.Index] != nil {
+			c.transfer(b, in[b.Index].clone())
+		}
+	}
+}
+
+// transfer applies the block statements to the facts and returns facts for
+// each successor.
+//
+// Blocks without successors end either with a return statement, including
+// the implicit one at the end of the function body, or with a call that does
+// not return, e.g. panic or log.Fatal. References are only checked for leaks
+// in the former case.
+func (c *checker) transfer(b *cfg.Block, f facts) []facts {
+	if b.Kind == cfg.KindRangeBody {
+		c.rangeValue(b.Stmt.(*ast.RangeStmt), f)
+	}
+	for _, n := range b.Nodes {
+		c.node(n, f)
+	}
+	if len(b.Succs) == 0 {
+		if ret := b.Return(); ret != nil {
+			c.exit(ret, f)
+		}
+		return nil
+	}
+	outs := make([]facts, len(b.Succs))
+	for i := range outs {
+		outs[i] = f
+	}
+	if len(b.Succs) == 2 && len(b.Nodes) > 0 {
+		if cond, ok := b.Nodes[len(b.Nodes)-1].(ast.Expr); ok {
+			outs[0], outs[1] = f.clone(), f.clone()
+			c.refine(cond, outs[0], outs[1])
+		}
+	}
+	return outs
+}
+
+// refine removes references that are known to be invalid, i.e. nil, not ok or
+// with a non-nil error, on the true and false branches of the condition.
+func (c *checker) refine(cond ast.Expr, t, f facts) {
+	cond = ast.Unparen(cond)
+	if u, ok := cond.(*ast.UnaryExpr); ok && u.Op == token.NOT {
+		c.refine(u.X, f, t)
+		return
+	}
+	if id, ok := cond.(*ast.Ident); ok {
+		obj := c.pass.TypesInfo.ObjectOf(id)
+		for k, v := range f {
+			if obj != nil && v.ok == obj {
+				delete(f, k)
+			}
+		}
+		return
+	}
+	bin, ok := cond.(*ast.BinaryExpr)
+	if !ok || (bin.Op != token.EQL && bin.Op != token.NEQ) {
+		return
+	}
+	x, y := ast.Unparen(bin.X), ast.Unparen(bin.Y)
+	if isNil(c.pass.TypesInfo, x) {
+		x, y = y, x
+	}
+	id, ok := x.(*ast.Ident)
+	if !ok || !isNil(c.pass.TypesInfo, y) {
+		return
+	}
+	obj := c.pass.TypesInfo.ObjectOf(id)
+	isNilBranch, notNilBranch := t, f
+	if bin.Op == token.NEQ {
+		isNilBranch, notNilBranch = f, t
+	}
+	delete(isNilBranch, obj)
+	for k, v := range notNilBranch {
+		if obj != nil && v.err == obj {
+			delete(notNilBranch, k)
+		}
+	}
+}
+
+func isNil(info *types.Info, e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = info.Uses[id].(*types.Nil)
+	return ok
+}
+
+// exit reports references that are owned when the function returns.
+func (c *checker) exit(ret *ast.ReturnStmt, f facts) {
+	for obj, r := range f {
+		if r.state&mayOwned == 0 || r.state&deferred != 0 {
+			continue
+		}
+		if r.discarded {
+			c.reportf(ret.Pos(), obj, "result of %s is not released on this path", r.origin)
+			continue
+		}
+		c.reportf(ret.Pos(), obj, "%s returned by %s is not released on this path", obj.Name(), r.origin)
+	}
+}
+
+// node applies the statement or expression to the facts.
+func (c *checker) node(n ast.Node, f facts) {
+	switch n := n.(type) {
+	case *ast.AssignStmt:
+		if len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+			if r, ok := c.elemRef(n.Rhs[0], f); ok {
+				c.assign(n.Lhs[0], r, f)
+				return
+			}
+		}
+		if len(n.Lhs) > 0 && len(n.Rhs) == 1 {
+			if call, ok := ast.Unparen(n.Rhs[0]).(*ast.CallExpr); ok {
+				c.escapes(n.Rhs[0], f)
+				c.call(call, n.Lhs, f, false)
+				return
+			}
+		}
+		for _, e := range n.Rhs {
+			c.escapes(e, f)
+		}
+		for _, e := range n.Lhs {
+			c.assign(e, ref{}, f)
+		}
+	case *ast.ValueSpec:
+		lhs := make([]ast.Expr, len(n.Names))
+		for i, name := range n.Names {
+			lhs[i] = name
+		}
+		if len(n.Values) == 1 {
+			if call, ok := ast.Unparen(n.Values[0]).(*ast.CallExpr); ok {
+				c.escapes(n.Values[0], f)
+				c.call(call, lhs, f, false)
+				return
+			}
+		}
+		for _, e := range n.Values {
+			c.escapes(e, f)
+		}
+	case *ast.ExprStmt:
+		c.escapes(n.X, f)
+		if call, ok := ast.Unparen(n.X).(*ast.CallExpr); ok {
+			c.call(call, nil, f, false)
+		}
+	case *ast.DeferStmt:
+		c.escapes(n.Call, f)
+		c.call(n.Call, nil, f, true)
+	case *ast.GoStmt:
+		c.escapes(n.Call, f)
+	case *ast.ReturnStmt:
+		for _, e := range n.Results {
+			c.escapes(e, f)
+			if id, ok := ast.Unparen(e).(*ast.Ident); ok {
+				// Ownership is transferred to the caller.
+				delete(f, c.pass.TypesInfo.ObjectOf(id))
+			}
+		}
+	case ast.Stmt:
+		ast.Inspect(n, func(n ast.Node) bool {
+			if e, ok := n.(ast.Expr); ok {
+				c.escapes(e, f)
+				return false
+			}
+			return true
+		})
+	case ast.Expr:
+		if c.ranges[n] {
+			// Ranging over a slice of borrowed references does not
+			// retain them, see rangeValue.
+			if id, ok := ast.Unparen(n).(*ast.Ident); ok && f[c.pass.TypesInfo.ObjectOf(id)].elems {
+				return
+			}
+		}
+		c.escapes(n, f)
+	}
+}
+
+// rangeValue records that the value variable of the range statement over
+// a slice of borrowed references is borrowed in the loop body.
+func (c *checker) rangeValue(s *ast.RangeStmt, f facts) {
+	if s.Value == nil {
+		return
+	}
+	switch x := ast.Unparen(s.X).(type) {
+	case *ast.Ident:
+		if r, ok := f[c.pass.TypesInfo.ObjectOf(x)]; ok && r.elems {
+			c.assign(s.Value, ref{state: borrowed, origin: r.origin}, f)
+		}
+	case *ast.CallExpr:
+		kind, fn := classify(c.pass, x)
+		if kind == callGet && returnsReferences(fn.Type().(*types.Signature)) {
+			c.assign(s.Value, ref{state: borrowed, origin: fn.Name()}, f)
+		}
+	}
+}
+
+// elemRef returns the reference for the element of a slice of borrowed
+// references, e.g. values[i].
+func (c *checker) elemRef(e ast.Expr, f facts) (ref, bool) {
+	ix, ok := ast.Unparen(e).(*ast.IndexExpr)
+	if !ok {
+		return ref{}, false
+	}
+	id, ok := ast.Unparen(ix.X).(*ast.Ident)
+	if !ok {
+		return ref{}, false
+	}
+	r, ok := f[c.pass.TypesInfo.ObjectOf(id)]
+	if !ok || !r.elems {
+		return ref{}, false
+	}
+	return ref{state: borrowed, origin: r.origin}, true
+}
+
+// call applies the effects of the call whose results are assigned to lhs.
+func (c *checker) call(call *ast.CallExpr, lhs []ast.Expr, f facts, isDefer bool) {
+	kind, fn := classify(c.pass, call)
+	switch kind {
+	case callCreate, callGet:
+		if isDefer {
+			return
+		}
+		r := ref{origin: fn.Name(), state: mayOwned}
+		if kind == callGet {
+			r.state = borrowed
+			r.elems = returnsReferences(fn.Type().(*types.Signature))
+		}
+		if len(lhs) > 1 {
+			for _, e := range lhs[1:] {
+				c.assign(e, ref{}, f)
+			}
+			// The last result reports a failure if it is an error,
+			// otherwise the second result is ok.
+			results := fn.Type().(*types.Signature).Results()
+			if i := len(lhs) - 1; i < results.Len() && isError(results.At(i).Type()) {
+				if id, ok := lhs[i].(*ast.Ident); ok && !isBlank(id) {
+					r.err = c.pass.TypesInfo.ObjectOf(id)
+				}
+			} else if id, ok := lhs[1].(*ast.Ident); ok && !isBlank(id) {
+				r.ok = c.pass.TypesInfo.ObjectOf(id)
+			}
+		}
+		if len(lhs) > 0 && !isBlank(lhs[0]) {
+			c.assign(lhs[0], r, f)
+			return
+		}
+		if kind == callGet {
+			return
+		}
+		if r.ok == nil && r.err == nil {
+			c.reportf(call.Pos(), nil, "result of %s is not released", fn.Name())
+			return
+		}
+		// The result is only valid if ok is true or err is nil, so track
+		// it using a placeholder variable.
+		obj, ok := c.discarded[call]
+		if !ok {
+			obj = types.NewVar(call.Pos(), c.pass.Pkg, "", nil)
+			c.discarded[call] = obj
+		}
+		r.discarded = true
+		f[obj] = r
+		return
+	case callRetain:
+		obj := c.argObject(call)
+		if _, ok := c.elemRef(call.Args[0], f); ok {
+			// Elements of a slice are not tracked individually.
+			obj = nil
+		}
+		if len(lhs) > 0 && !isBlank(lhs[0]) {
+			c.assign(lhs[0], ref{origin: fn.Name(), state: mayOwned}, f)
+			return
+		}
+		if r, ok := f[obj]; ok && !isDefer {
+			// The argument is retained in place, e.g. _ = Retain(v).
+			r.state = r.state&^(borrowed|mayReleased) | mayOwned
+			f[obj] = r
+		}
+	case callRelease:
+		if r, ok := c.elemRef(call.Args[0], f); ok {
+			c.reportf(call.Pos(), nil, "element of slice returned by %s is borrowed and must not be released", r.origin)
+			return
+		}
+		obj := c.argObject(call)
+		r, ok := f[obj]
+		if !ok {
+			return
+		}
+		switch {
+		case r.state&borrowed != 0:
+			c.reportf(call.Pos(), obj, "%s returned by %s is borrowed and must not be released", obj.Name(), r.origin)
+		case r.state&mayReleased != 0:
+			c.reportf(call.Pos(), obj, "%s returned by %s is released twice", obj.Name(), r.origin)
+		}
+		r.state = r.state&^(mayOwned|borrowed) | mayReleased
+		if isDefer {
+			r.state |= deferred
+		}
+		f[obj] = r
+	}
+	for _, e := range lhs {
+		c.assign(e, ref{}, f)
+	}
+}
+
+// assign records that the reference r is assigned to the expression. If the
+// expression is a tracked variable that may still be owned, the previous
+// reference leaks.
+func (c *checker) assign(e ast.Expr, r ref, f facts) {
+	id, ok := ast.Unparen(e).(*ast.Ident)
+	if !ok || isBlank(id) {
+		return
+	}
+	obj := c.pass.TypesInfo.ObjectOf(id)
+	if obj == nil || obj.Parent() == obj.Pkg().Scope() {
+		return
+	}
+	if old, ok := f[obj]; ok && old.state&mayOwned != 0 && old.state&deferred == 0 {
+		c.reportf(id.Pos(), obj, "%s returned by %s is overwritten before it is released", obj.Name(), old.origin)
+	}
+	for k, v := range f {
+		// The ok or err result no longer describes the reference.
+		if v.ok == obj {
+			v.ok = nil
+			f[k] = v
+		}
+		if v.err == obj {
+			v.err = nil
+			f[k] = v
+		}
+	}
+	if r.state == 0 {
+		delete(f, obj)
+		return
+	}
+	f[obj] = r
+}
+
+// argObject returns the variable passed as the only argument of the call.
+func (c *checker) argObject(call *ast.CallExpr) types.Object {
+	if len(call.Args) != 1 {
+		return nil
+	}
+	id, ok := ast.Unparen(call.Args[0]).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	return c.pass.TypesInfo.ObjectOf(id)
+}
+
+// escapes stops tracking references that escape in the expression, i.e. that
+// are used anywhere except as arguments to the bindings, operands of
+// comparisons and method call receivers.
+func (c *checker) escapes(e ast.Expr, f facts) {
+	if len(f) == 0 {
+		return
+	}
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// References captured by closures escape.
+			ast.Inspect(n.Body, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok {
+					delete(f, c.pass.TypesInfo.ObjectOf(id))
+				}
+				return true
+			})
+			return false
+		case *ast.CallExpr:
+			if kind, _ := classify(c.pass, n); kind != callOther {
+				ast.Inspect(n.Fun, visit)
+				for _, arg := range n.Args {
+					if _, ok := ast.Unparen(arg).(*ast.Ident); ok {
+						continue
+					}
+					if _, ok := c.elemRef(arg, f); ok {
+						continue
+					}
+					ast.Inspect(arg, visit)
+				}
+				return false
+			}
+			if id, ok := ast.Unparen(n.Fun).(*ast.Ident); ok {
+				if b, ok := c.pass.TypesInfo.Uses[id].(*types.Builtin); ok && (b.Name() == "len" || b.Name() == "cap") {
+					return false
+				}
+			}
+			if tv, ok := c.pass.TypesInfo.Types[n.Fun]; ok && tv.IsType() && !types.IsInterface(tv.Type) {
+				// Conversions to non-interface types, e.g. uintptr(v), do not
+				// retain the reference.
+				return false
+			}
+		case *ast.BinaryExpr:
+			if n.Op == token.EQL || n.Op == token.NEQ {
+				return false
+			}
+		case *ast.SelectorExpr:
+			if _, ok := ast.Unparen(n.X).(*ast.Ident); ok {
+				// Method call receivers and package selectors.
+				return false
+			}
+		case *ast.Ident:
+			delete(f, c.pass.TypesInfo.ObjectOf(n))
+		}
+		return true
+	}
+	ast.Inspect(e, visit)
+}
+
+func isBlank(e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == "_"
+}
@@ -0,0 +1,13 @@
+package ownership_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/noncgo/x/darwin/analysis/ownership"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), ownership.Analyzer, "a")
+}
@@ -0,0 +1,266 @@
+package a
+
+import (
+	"errors"
+	"log"
+
+	cf "github.com/noncgo/x/darwin/corefoundation"
+	"github.com/noncgo/x/darwin/coreservices/fsevents"
+)
+
+func released() {
+	s, ok := cf.CreateStringWithBytes(cf.AllocatorDefault(), []byte("x"))
+	if !ok {
+		return
+	}
+	_ = cf.GetTypeID(s)
+	cf.Release(s)
+}
+
+func deferred() error {
+	s, ok := cf.CreateStringWithBytes(cf.AllocatorDefault(), nil)
+	if !ok {
+		return errors.New("failed")
+	}
+	defer cf.Release(s)
+	if cf.GetTypeID(s) == 0 {
+		return errors.New("unexpected type")
+	}
+	return nil
+}
+
+func leaked() {
+	s, ok := cf.CreateStringWithBytes(cf.AllocatorDefault(), nil)
+	if !ok {
+		return
+	}
+	_ = cf.GetTypeID(s)
+} // want `s returned by CreateStringWithBytes is not released on this path`
+
+func leakedOnErrorPath(fail bool) error {
+	a, ok := cf.CreateMutableArray(cf.AllocatorDefault(), 0)
+	if !ok {
+		return errors.New("failed")
+	}
+	if fail {
+		return errors.New("failed") // want `a returned by CreateMutableArray is not released on this path`
+	}
+	cf.Release(a)
+	return nil
+}
+
+func releasedError(v cf.Object) error {
+	d, err := cf.CreatePropertyListData(cf.AllocatorDefault(), v)
+	if err != nil {
+		return err
+	}
+	defer cf.Release(d)
+	return nil
+}
+
+func leakedError(v cf.Object) error {
+	d, err := cf.CreatePropertyListData(cf.AllocatorDefault(), v)
+	if err == nil {
+		_ = cf.GetTypeID(d)
+	}
+	return err // want `d returned by CreatePropertyListData is not released on this path`
+}
+
+func discardedError(v cf.Object) error {
+	_, err := cf.CreatePropertyListData(cf.AllocatorDefault(), v)
+	if err != nil {
+		return err
+	}
+	return nil // want `result of CreatePropertyListData is not released on this path`
+}
+
+func leakedNew() {
+	s := cf.NewString("x")
+	_ = cf.GetTypeID(s)
+} // want `s returned by NewString is not released on this path`
+
+func leakedCopy(v cf.Object) {
+	d := cf.CopyDescription(v)
+	if d == nil {
+		return
+	}
+	_ = cf.GetTypeID(d)
+	return // want `d returned by CopyDescription is not released on this path`
+}
+
+func discarded(v cf.Object) {
+	cf.CopyDescription(v)     // want `result of CopyDescription is not released`
+	_ = cf.CopyDescription(v) // want `result of CopyDescription is not released`
+}
+
+func doubleRelease(v cf.Object) {
+	d := cf.CopyDescription(v)
+	cf.Release(d)
+	cf.Release(d) // want `d returned by CopyDescription is released twice`
+}
+
+func doubleReleaseDeferred(v cf.Object) {
+	d := cf.CopyDescription(v)
+	defer cf.Release(d)
+	cf.Release(d) // want `d returned by CopyDescription is released twice`
+}
+
+func maybeDoubleRelease(v cf.Object, early bool) {
+	d := cf.CopyDescription(v)
+	if early {
+		cf.Release(d)
+	}
+	cf.Release(d) // want `d returned by CopyDescription is released twice`
+}
+
+func borrowed() {
+	r := cf.GetCurrentRunLoop()
+	cf.Release(r) // want `r returned by GetCurrentRunLoop is borrowed and must not be released`
+}
+
+func retained() {
+	r := cf.GetCurrentRunLoop()
+	_ = cf.Retain(r)
+	cf.Release(r)
+}
+
+func retainedCopy() {
+	r := cf.Retain(cf.GetCurrentRunLoop())
+	_ = cf.GetTypeID(r)
+} // want `r returned by Retain is not released on this path`
+
+func overwritten(v cf.Object) {
+	d := cf.CopyDescription(v)
+	d = cf.CopyDescription(v) // want `d returned by CopyDescription is overwritten before it is released`
+	cf.Release(d)
+}
+
+func returned() (cf.MutableArray, bool) {
+	a, ok := cf.CreateMutableArray(cf.AllocatorDefault(), 0)
+	return a, ok
+}
+
+func appended(v cf.Object) cf.MutableArray {
+	a, ok := cf.CreateMutableArray(cf.AllocatorDefault(), 1)
+	if !ok {
+		log.Fatal("failed to create array")
+	}
+	s := cf.CopyDescription(v)
+	cf.AppendToArray(a, s)
+	cf.Release(s)
+	return a
+}
+
+func escapes(v cf.Object, c chan cf.Object) {
+	s := cf.CopyDescription(v)
+	c <- s
+	t := cf.CopyDescription(v)
+	defer func() {
+		cf.Release(t)
+	}()
+}
+
+func loop(n int) {
+	for i := 0; i < n; i++ {
+		s, ok := cf.CreateStringWithBytes(cf.AllocatorDefault(), nil)
+		if !ok {
+			continue
+		}
+		cf.Release(s)
+	}
+}
+
+func stream(paths cf.Array) {
+	s, ok := fsevents.CreateStream(paths)
+	if !ok {
+		log.Fatal("failed to create stream")
+	}
+	if !fsevents.StartStream(s) {
+		return // want `s returned by CreateStream is not released on this path`
+	}
+	fsevents.ReleaseStream(s)
+}
+
+func streamRetained(s fsevents.Stream) {
+	fsevents.RetainStream(s)
+	fsevents.ReleaseStream(s)
+}
+
+func discardedChecked() {
+	_, ok := cf.CreateMutableArray(cf.AllocatorNone(), 0)
+	if ok {
+		panic("unexpected success")
+	}
+}
+
+func discardedLeaked() {
+	_, ok := cf.CreateMutableArray(cf.AllocatorDefault(), 0)
+	if !ok {
+		return
+	}
+} // want `result of CreateMutableArray is not released on this path`
+
+func leakedFromGo() error {
+	v, err := cf.FromGo("x")
+	if err != nil {
+		return err
+	}
+	_ = cf.GetTypeID(v)
+	return nil // want `v returned by FromGo is not released on this path`
+}
+
+func releasedArrayValueAt(a cf.Array) {
+	v := cf.ArrayValueAt(a, 0)
+	cf.Release(v) // want `v returned by ArrayValueAt is borrowed and must not be released`
+}
+
+func releasedDictionaryValue(d cf.Dictionary, key cf.Object) {
+	v, ok := cf.DictionaryValue(d, key)
+	if !ok {
+		return
+	}
+	cf.Release(v) // want `v returned by DictionaryValue is borrowed and must not be released`
+}
+
+func retainedDictionaryValue(d cf.Dictionary, key cf.Object) cf.Object {
+	v, ok := cf.DictionaryValue(d, key)
+	if !ok {
+		return nil
+	}
+	return cf.Retain(v)
+}
+
+func releasedArrayValues(a cf.Array) {
+	vs := cf.ArrayValues(a, cf.Range{Length: 2})
+	if len(vs) == 0 {
+		return
+	}
+	cf.Release(vs[0]) // want `element of slice returned by ArrayValues is borrowed and must not be released`
+}
+
+func releasedArrayValuesRange(a cf.Array) {
+	for _, v := range cf.ArrayValues(a, cf.Range{Length: 2}) {
+		cf.Release(v) // want `v returned by ArrayValues is borrowed and must not be released`
+	}
+	vs := cf.ArrayValues(a, cf.Range{Length: 2})
+	for _, v := range vs {
+		cf.Release(v) // want `v returned by ArrayValues is borrowed and must not be released`
+	}
+}
+
+func retainedArrayValues(a cf.Array) cf.Object {
+	vs := cf.ArrayValues(a, cf.Range{Length: 2})
+	if len(vs) == 0 {
+		return nil
+	}
+	v := vs[0]
+	return cf.Retain(v)
+}
+
+func releasedArrayValuesElem(a cf.Array) {
+	vs := cf.ArrayValues(a, cf.Range{Length: 2})
+	for i := range vs {
+		v := vs[i]
+		cf.Release(v) // want `v returned by ArrayValues is borrowed and must not be released`
+	}
+}
@@ -0,0 +1,58 @@
+// Package corefoundation is a stub of the bindings package for tests.
+package corefoundation
+
+type Object interface{ Pointer() uintptr }
+
+type (
+	Allocator    Object
+	Array        Object
+	MutableArray Object
+	String       Object
+	Data         Object
+	RunLoop      Object
+	Dictionary   Object
+)
+
+type Range struct{ Location, Length int }
+
+type TypeID uint
+
+func AllocatorDefault() Allocator { return nil }
+
+func AllocatorNone() Allocator { return nil }
+
+func CreateMutableArray(alloc Allocator, capacity int) (MutableArray, bool) { return nil, false }
+
+func CreateStringWithBytes(alloc Allocator, data []byte) (String, bool) { return nil, false }
+
+func NewString(s string) String { return nil }
+
+func CreatePropertyListData(alloc Allocator, v Object) (Data, error) { return nil, nil }
+
+func CopyDescription(v Object) String { return nil }
+
+func GetCurrentRunLoop() RunLoop { return nil }
+
+func GetTypeID(v Object) TypeID { return 0 }
+
+// FromGo converts a Go value to an object.
+// The caller owns the returned reference.
+func FromGo(v interface{}) (Object, error) { return nil, nil }
+
+// ArrayValueAt returns the value at the index of the array.
+// The array retains the ownership of the returned value.
+func ArrayValueAt(a Array, i int) Object { return nil }
+
+// ArrayValues returns the values of the array in the range.
+// The array retains the ownership of the returned values.
+func ArrayValues(a Array, r Range) []Object { return nil }
+
+// DictionaryValue returns the value for the key in the dictionary.
+// The dictionary retains the ownership of the returned value.
+func DictionaryValue(d Dictionary, key Object) (Object, bool) { return nil, false }
+
+func AppendToArray(m MutableArray, v Object) {}
+
+func Retain(v Object) Object { return v }
+
+func Release(v Object) {}
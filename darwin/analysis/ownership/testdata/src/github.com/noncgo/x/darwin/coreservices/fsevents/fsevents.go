@@ -0,0 +1,14 @@
+// Package fsevents is a stub of the bindings package for tests.
+package fsevents
+
+import "github.com/noncgo/x/darwin/corefoundation"
+
+type Stream interface{ Pointer() uintptr }
+
+func CreateStream(paths corefoundation.Array) (Stream, bool) { return nil, false }
+
+func StartStream(s Stream) bool { return false }
+
+func RetainStream(s Stream) {}
+
+func ReleaseStream(s Stream) {}
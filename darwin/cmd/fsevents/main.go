@@ -29,9 +29,12 @@ var (
 	markSelf    = flag.Bool("mark-self", false, "")
 	useExtData  = flag.Bool("use-ext-data", true, "")
 	fullHistory = flag.Bool("full-history", false, "")
+
+	extraFlags fsevents.CreateFlags
 )
 
 func main() {
+	flag.Var(&extraFlags, "flags", "additional stream creation flags, e.g. NoDefer|WatchRoot, that are combined with the boolean flags and cannot unset them")
 	flag.Parse()
 
 	args := flag.Args()
@@ -39,27 +42,13 @@ func main() {
 		args = []string{"."}
 	}
 
-	paths, ok := corefoundation.CreateMutableArray(
+	paths, ok := corefoundation.CreateArrayFromStrings(
 		corefoundation.AllocatorDefault(),
-		len(args),
-		corefoundation.ArrayCallbacksForObject(),
+		args,
 	)
 	if !ok {
 		log.Fatal("failed to create paths array")
 	}
-	for _, arg := range args {
-		v, ok := corefoundation.CreateStringWithBytes(
-			corefoundation.AllocatorDefault(),
-			[]byte(arg),
-			corefoundation.StringEncodingUTF8,
-			false,
-		)
-		if !ok {
-			log.Fatal("failed to create path string")
-		}
-		corefoundation.AppendToArray(paths, v)
-		corefoundation.Release(v)
-	}
 	stream, ok := fsevents.CreateStream(
 		corefoundation.AllocatorDefault(),
 		func(s fsevents.ConstStream, info any, events ...fsevents.Event) {
@@ -132,8 +121,11 @@ func createRunLoop() (corefoundation.RunLoop, func()) {
 	}
 }
 
+// streamFlags returns the stream creation flags set by the boolean flags and
+// the flags flag. Flags may only be added, so use the boolean flags to unset
+// the defaults, e.g. -use-ext-data=false.
 func streamFlags() fsevents.CreateFlags {
-	var v fsevents.CreateFlags
+	v := extraFlags
 	if *useCFTypes {
 		v |= fsevents.CreateFlagUseCFTypes
 	}
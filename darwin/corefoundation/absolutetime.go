@@ -0,0 +1,44 @@
+package corefoundation
+
+// This file provides CFAbsoluteTime conversions. Unlike other files in the
+// package, it does not depend on Core Foundation and builds on all platforms.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfabsolutetime
+
+import (
+	"math"
+	"time"
+)
+
+// AbsoluteTime is a time in seconds relative to the absolute reference date
+// of 1 January 2001 00:00:00 UTC.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfabsolutetime
+//  • https://developer.apple.com/documentation/corefoundation/kcfabsolutetimeintervalsince1970
+type AbsoluteTime float64
+
+// absoluteTimeIntervalSince1970 is the number of seconds between the Unix
+// epoch and the absolute reference date.
+const absoluteTimeIntervalSince1970 = 978307200
+
+// AbsoluteTimeOf returns t as AbsoluteTime.
+//
+// The difference between the epochs is applied to whole seconds in integer
+// arithmetic, so the only loss of precision is rounding of the fractional
+// second to float64. In particular, times with whole seconds convert exactly
+// if the result is within ±2⁵³ seconds.
+func AbsoluteTimeOf(t time.Time) AbsoluteTime {
+	sec := t.Unix() - absoluteTimeIntervalSince1970
+	return AbsoluteTime(float64(sec) + float64(t.Nanosecond())/1e9)
+}
+
+// Time returns the time in the local time zone rounded to the nearest
+// nanosecond. The result is undefined for infinite, NaN or out of range
+// values that time.Time cannot represent.
+func (at AbsoluteTime) Time() time.Time {
+	sec, frac := math.Modf(float64(at))
+	nsec := math.Round(frac * 1e9)
+	return time.Unix(int64(sec)+absoluteTimeIntervalSince1970, int64(nsec))
+}
@@ -0,0 +1,62 @@
+package corefoundation_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestAbsoluteTimeOf(t *testing.T) {
+	testCases := []struct {
+		t  time.Time
+		at corefoundation.AbsoluteTime
+	}{
+		{time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC), 0},
+		{time.Unix(0, 0), -978307200},
+		{time.Date(2001, 1, 1, 0, 0, 1, 500000000, time.UTC), 1.5},
+		{time.Date(2000, 12, 31, 23, 59, 59, 750000000, time.UTC), -0.25},
+		{time.Date(2022, 6, 1, 12, 0, 0, 0, time.FixedZone("UTC+3", 3*60*60)), 675766800},
+		{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), -63113904000},
+		{time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC), 252423993599},
+	}
+	for _, tc := range testCases {
+		if at := corefoundation.AbsoluteTimeOf(tc.t); at != tc.at {
+			t.Errorf("AbsoluteTimeOf(%v) = %v, want %v", tc.t, at, tc.at)
+		}
+		if got := tc.at.Time(); !got.Equal(tc.t) {
+			t.Errorf("AbsoluteTime(%v).Time() = %v, want %v", tc.at, got, tc.t)
+		}
+	}
+}
+
+func TestAbsoluteTimeRoundTrip(t *testing.T) {
+	// Near the reference date float64 has sub-nanosecond precision, so the
+	// conversion round-trips exactly.
+	ref := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, d := range []time.Duration{1, -1, 123456789, -987654321, 24*time.Hour + 1} {
+		want := ref.Add(d)
+		if got := corefoundation.AbsoluteTimeOf(want).Time(); !got.Equal(want) {
+			t.Errorf("round trip of %v: got %v", want, got)
+		}
+	}
+
+	// Otherwise, the time is within a unit in the last place of the float64
+	// value, and converting the float64 value back is exact.
+	for _, want := range []time.Time{
+		time.Date(2022, 6, 1, 12, 34, 56, 123456789, time.UTC),
+		time.Date(1970, 1, 1, 0, 0, 0, 1, time.UTC),
+		time.Date(2100, 1, 1, 0, 0, 0, 999999999, time.UTC),
+	} {
+		at := corefoundation.AbsoluteTimeOf(want)
+		got := at.Time()
+		ulp := math.Nextafter(float64(at), math.Inf(1)) - float64(at)
+		if diff := got.Sub(want).Seconds(); math.Abs(diff) > ulp {
+			t.Errorf("round trip of %v: got %v, off by %v", want, got, diff)
+		}
+		if back := corefoundation.AbsoluteTimeOf(got); back != at {
+			t.Errorf("AbsoluteTimeOf(%v) = %v, want %v", got, back, at)
+		}
+	}
+}
@@ -9,8 +9,10 @@ package corefoundation
 //  • https://developer.apple.com/documentation/corefoundation/cfallocator
 
 import (
+	"sync"
 	"unsafe"
 
+	"github.com/noncgo/x/darwin/internal/callback"
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -20,64 +22,302 @@ import (
 //  • https://developer.apple.com/documentation/corefoundation/cfallocatorref
 type Allocator types.CFAllocator
 
-// AllocatorDefault returns an allocator that is synonym for NULL.
+// AllocatorCallbacks is a structure containing the callbacks of an allocator
+// created with CreateAllocator.
+//
+// Callbacks are called from C using internal/callback package. They may call
+// Core Foundation functions, e.g. to forward requests to another allocator
+// with AllocatorAllocate and similar functions. A nil callback has the same
+// meaning as NULL function pointer in C.
+//
+// Callbacks can only be called on threads created by Go, e.g. by functions
+// called from Go or by a run loop running on a goroutine. On other threads,
+// the callbacks are not called and the requests fail: allocations return
+// NULL, while deallocations and the final release leak. So such allocators
+// are not suitable for objects that Core Foundation or other frameworks may
+// use or release on their own threads, e.g. objects passed to dispatch queues,
+// FSEvents streams or run loops of threads not created by Go.
 //
 // References
-//  • https://developer.apple.com/documentation/corefoundation/kcfallocatordefault
-func AllocatorDefault() Allocator {
-	addr := extern_kCFAllocatorDefault_getAddr()
-	addr = **(**uintptr)(unsafe.Pointer(&addr))
-	return types.Pointer(addr)
+//  • https://developer.apple.com/documentation/corefoundation/cfallocatorcontext
+type AllocatorCallbacks struct {
+	// Allocate allocates a block of at least size bytes and returns its
+	// address, or zero on failure. If it is nil, the allocator cannot
+	// allocate memory.
+	Allocate func(size int, hint uint) uintptr
+	// Reallocate resizes the block at ptr to at least size bytes and
+	// returns the address of the block, or zero on failure. Both ptr and
+	// size are not zero since Core Foundation handles these cases with
+	// Allocate and Deallocate. If it is nil, reallocation fails.
+	Reallocate func(ptr uintptr, size int, hint uint) uintptr
+	// Deallocate frees the block at ptr.
+	Deallocate func(ptr uintptr)
+	// PreferredSize returns the size of the block that the allocator would
+	// actually allocate for a request of size bytes. If it is nil, the
+	// size is returned as is.
+	PreferredSize func(size int, hint uint) int
+	// Release is called when the allocator is deallocated. No other
+	// callbacks are called after it.
+	Release func()
 }
 
-// AllocatorSystem returns default system allocator.
+// allocatorContext is the CFAllocatorContext structure.
 //
-// Refererences
-//  • https://developer.apple.com/documentation/corefoundation/kcfallocatorsystemdefault
-func AllocatorSystem() Allocator {
-	addr := extern_kCFAllocatorSystemDefault_getAddr()
-	addr = **(**uintptr)(unsafe.Pointer(&addr))
-	return types.Pointer(addr)
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfallocatorcontext
+type allocatorContext struct {
+	Version         int
+	Info            uintptr
+	Retain          uintptr
+	Release         uintptr
+	CopyDescription uintptr
+	Allocate        uintptr
+	Reallocate      uintptr
+	Deallocate      uintptr
+	PreferredSize   uintptr
+}
+
+// goAllocators holds the shared C callbacks and the state of allocators
+// created with CreateAllocator.
+var goAllocators struct {
+	once  sync.Once
+	ctxt  allocatorContext
+	err   error
+	infos contextInfos
 }
 
-// AllocatorMalloc returns an allocator that uses malloc, realloc and free.
+// CreateAllocator creates an allocator that implements allocation requests
+// with Go callbacks. The callbacks are copied, so later changes to the
+// structure do not affect the allocator. Nil callbacks are equivalent to an
+// empty structure, i.e. the allocator cannot allocate memory.
+//
+// The allocator object itself is allocated with alloc. If alloc is
+// AllocatorUseContext, it is allocated with the given callbacks, and
+// Deallocate is called for it before Release.
+//
+// If there was a problem creating the object, it returns false. The caller
+// owns the returned reference.
 //
 // References
-//  • https://developer.apple.com/documentation/corefoundation/kcfallocatormalloc
-func AllocatorMalloc() Allocator {
-	addr := extern_kCFAllocatorMalloc_getAddr()
-	addr = **(**uintptr)(unsafe.Pointer(&addr))
-	return types.Pointer(addr)
+//  • https://developer.apple.com/documentation/corefoundation/cfallocatorcreate(_:_:)
+func CreateAllocator(alloc Allocator, callbacks *AllocatorCallbacks) (Allocator, bool) {
+	ga := &goAllocators
+	ga.once.Do(initGoAllocators)
+	if ga.err != nil {
+		return nil, false
+	}
+
+	var cb AllocatorCallbacks
+	if callbacks != nil {
+		cb = *callbacks
+	}
+	ctxt := ga.ctxt
+	ctxt.Info = ga.infos.add(&cb)
+	out := cfAllocatorCreate(alloc, unsafe.Pointer(&ctxt))
+	if out == 0 {
+		ga.infos.remove(ctxt.Info)
+		return nil, false
+	}
+	return out, true
 }
 
-// AllocatorMallocZone returns an allocator that explicitly uses the default
-// malloc zone, returned by malloc_default_zone.
+// lookupGoAllocator returns the callbacks for the context info.
+func lookupGoAllocator(info uintptr) *AllocatorCallbacks {
+	return goAllocators.infos.get(info).(*AllocatorCallbacks)
+}
+
+func initGoAllocators() {
+	ga := &goAllocators
+	ga.err = newCallbacks([]callbackSlot{
+		// void release(const void *info)
+		{&ga.ctxt.Release, func(args callback.Args) uintptr {
+			cb := ga.infos.remove(args[0]).(*AllocatorCallbacks)
+			if cb.Release != nil {
+				cb.Release()
+			}
+			return 0
+		}},
+		// void *allocate(CFIndex allocSize, CFOptionFlags hint, void *info)
+		{&ga.ctxt.Allocate, func(args callback.Args) uintptr {
+			cb := lookupGoAllocator(args[2])
+			if cb.Allocate == nil {
+				return 0
+			}
+			return cb.Allocate(int(args[0]), uint(args[1]))
+		}},
+		// void *reallocate(void *ptr, CFIndex newsize, CFOptionFlags hint, void *info)
+		{&ga.ctxt.Reallocate, func(args callback.Args) uintptr {
+			cb := lookupGoAllocator(args[3])
+			if cb.Reallocate == nil {
+				return 0
+			}
+			return cb.Reallocate(args[0], int(args[1]), uint(args[2]))
+		}},
+		// void deallocate(void *ptr, void *info)
+		{&ga.ctxt.Deallocate, func(args callback.Args) uintptr {
+			cb := lookupGoAllocator(args[1])
+			if cb.Deallocate != nil {
+				cb.Deallocate(args[0])
+			}
+			return 0
+		}},
+		// CFIndex preferredSize(CFIndex size, CFOptionFlags hint, void *info)
+		{&ga.ctxt.PreferredSize, func(args callback.Args) uintptr {
+			cb := lookupGoAllocator(args[2])
+			if cb.PreferredSize == nil {
+				return args[0]
+			}
+			return uintptr(cb.PreferredSize(int(args[0]), uint(args[1])))
+		}},
+	})
+}
+
+// AllocatorAllocate allocates a block of at least size bytes with the
+// allocator and returns its address, or zero on failure.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfallocatorallocate(_:_:_:)
+func AllocatorAllocate(alloc Allocator, size int, hint uint) uintptr {
+	return cfAllocatorAllocate(alloc, size, hint)
+}
+
+// AllocatorReallocate resizes the block at ptr that was allocated with the
+// allocator. If ptr is zero, it allocates a new block, and if size is zero,
+// it deallocates the block and returns zero.
 //
 // References
-//  • https://developer.apple.com/documentation/corefoundation/kcfallocatormalloczone
-func AllocatorMallocZone() Allocator {
-	addr := extern_kCFAllocatorMallocZone_getAddr()
-	addr = **(**uintptr)(unsafe.Pointer(&addr))
-	return types.Pointer(addr)
+//  • https://developer.apple.com/documentation/corefoundation/cfallocatorreallocate(_:_:_:_:)
+func AllocatorReallocate(alloc Allocator, ptr uintptr, size int, hint uint) uintptr {
+	return cfAllocatorReallocate(alloc, ptr, size, hint)
 }
 
-// AllocatorNone returns an allocator does not nothing.
+// AllocatorDeallocate frees the block at ptr that was allocated with the
+// allocator.
 //
 // References
-//  • https://developer.apple.com/documentation/corefoundation/kcfallocatornull
-func AllocatorNone() Allocator {
-	addr := extern_kCFAllocatorNull_getAddr()
-	addr = **(**uintptr)(unsafe.Pointer(&addr))
-	return types.Pointer(addr)
+//  • https://developer.apple.com/documentation/corefoundation/cfallocatordeallocate(_:_:)
+func AllocatorDeallocate(alloc Allocator, ptr uintptr) {
+	cfAllocatorDeallocate(alloc, ptr)
 }
 
-// AllocatorUseContext returns a special allocator argument to CreateAllocator
-// that uses the functions given in the context to allocate the allocator.
+// AllocatorPreferredSize returns the size of the block that the allocator
+// would actually allocate for a request of size bytes.
 //
 // References
-//  • https://developer.apple.com/documentation/corefoundation/kcfallocatorusecontext
-func AllocatorUseContext() Allocator {
-	addr := extern_kCFAllocatorUseContext_getAddr()
-	addr = **(**uintptr)(unsafe.Pointer(&addr))
-	return types.Pointer(addr)
+//  • https://developer.apple.com/documentation/corefoundation/cfallocatorgetpreferredsizeforsize(_:_:_:)
+func AllocatorPreferredSize(alloc Allocator, size int, hint uint) int {
+	return cfAllocatorGetPreferredSizeForSize(alloc, size, hint)
+}
+
+// noCopyBytes is Go memory used by a Core Foundation object without copying.
+type noCopyBytes struct {
+	// b keeps the Go memory reachable until it is deallocated.
+	b    []byte
+	free func()
+}
+
+// bytesDeallocator is the shared bytes deallocator for objects that use Go
+// memory without copying, e.g. CFDataCreateWithBytesNoCopy. It cannot
+// allocate memory, and deallocating a block releases the Go memory registered
+// for its address.
+var bytesDeallocator struct {
+	once  sync.Once
+	alloc Allocator
+
+	sync.Mutex
+	// bytes maps the address of the first byte to the memory registered
+	// for it, in the order of registration.
+	bytes map[uintptr][]*noCopyBytes
+}
+
+// registerNoCopyBytes keeps b reachable until it is deallocated with the
+// returned bytes deallocator, calling free if it is not nil. If b is used by
+// several objects at the same time, each deallocation calls one of the free
+// functions. The caller must call unregisterNoCopyBytes if creating the
+// object fails. The length of b must not be zero.
+//
+// The package retains the ownership of the returned allocator.
+func registerNoCopyBytes(b []byte, free func()) (Allocator, bool) {
+	bd := &bytesDeallocator
+	bd.once.Do(initBytesDeallocator)
+	if bd.alloc == nil {
+		return nil, false
+	}
+
+	p := uintptr(unsafe.Pointer(&b[0]))
+	bd.Lock()
+	defer bd.Unlock()
+	if bd.bytes == nil {
+		bd.bytes = make(map[uintptr][]*noCopyBytes)
+	}
+	bd.bytes[p] = append(bd.bytes[p], &noCopyBytes{b: b, free: free})
+	return bd.alloc, true
+}
+
+// unregisterNoCopyBytes removes the memory registered last for the address of
+// b without calling its free function.
+func unregisterNoCopyBytes(b []byte) {
+	takeNoCopyBytes(uintptr(unsafe.Pointer(&b[0])), true)
+}
+
+// takeNoCopyBytes removes the memory registered first, or last, for address p
+// and returns it. It returns nil if there is no memory registered for p.
+func takeNoCopyBytes(p uintptr, last bool) *noCopyBytes {
+	bd := &bytesDeallocator
+	bd.Lock()
+	defer bd.Unlock()
+	list := bd.bytes[p]
+	if len(list) == 0 {
+		return nil
+	}
+	var nb *noCopyBytes
+	if last {
+		nb, list = list[len(list)-1], list[:len(list)-1]
+	} else {
+		nb, list = list[0], list[1:]
+	}
+	if len(list) == 0 {
+		delete(bd.bytes, p)
+	} else {
+		bd.bytes[p] = list
+	}
+	return nb
+}
+
+func initBytesDeallocator() {
+	bd := &bytesDeallocator
+	alloc, ok := CreateAllocator(AllocatorDefault(), &AllocatorCallbacks{
+		Deallocate: func(ptr uintptr) {
+			if nb := takeNoCopyBytes(ptr, false); nb != nil && nb.free != nil {
+				nb.free()
+			}
+		},
+	})
+	if ok {
+		bd.alloc = alloc
+	}
+}
+
+// createForwardingAllocator creates an allocator that forwards allocation
+// requests to the parent allocator and calls onRelease when the allocator is
+// deallocated. Since Core Foundation objects retain their allocators, it can
+// be used to tie the lifetime of Go state to an object that has no other
+// means of notification, e.g. callbacks of an array.
+func createForwardingAllocator(parent Allocator, onRelease func()) (Allocator, bool) {
+	p := types.Pointer(objectAddr(parent))
+	return CreateAllocator(p, &AllocatorCallbacks{
+		Allocate: func(size int, hint uint) uintptr {
+			return cfAllocatorAllocate(p, size, hint)
+		},
+		Reallocate: func(ptr uintptr, size int, hint uint) uintptr {
+			return cfAllocatorReallocate(p, ptr, size, hint)
+		},
+		Deallocate: func(ptr uintptr) {
+			cfAllocatorDeallocate(p, ptr)
+		},
+		PreferredSize: func(size int, hint uint) int {
+			return cfAllocatorGetPreferredSizeForSize(p, size, hint)
+		},
+		Release: onRelease,
+	})
 }
@@ -0,0 +1,169 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+// countingAllocator counts memory allocated by Core Foundation objects and
+// forwards requests to the malloc allocator.
+type countingAllocator struct {
+	mu       sync.Mutex
+	blocks   map[uintptr]int
+	live     int
+	released bool
+}
+
+func (c *countingAllocator) callbacks() *corefoundation.AllocatorCallbacks {
+	parent := corefoundation.AllocatorMalloc()
+	c.blocks = make(map[uintptr]int)
+	return &corefoundation.AllocatorCallbacks{
+		Allocate: func(size int, hint uint) uintptr {
+			p := corefoundation.AllocatorAllocate(parent, size, hint)
+			c.update(0, p, size)
+			return p
+		},
+		Reallocate: func(ptr uintptr, size int, hint uint) uintptr {
+			p := corefoundation.AllocatorReallocate(parent, ptr, size, hint)
+			if p != 0 {
+				c.update(ptr, p, size)
+			}
+			return p
+		},
+		Deallocate: func(ptr uintptr) {
+			c.update(ptr, 0, 0)
+			corefoundation.AllocatorDeallocate(parent, ptr)
+		},
+		PreferredSize: func(size int, hint uint) int {
+			return corefoundation.AllocatorPreferredSize(parent, size, hint)
+		},
+		Release: func() {
+			c.mu.Lock()
+			c.released = true
+			c.mu.Unlock()
+		},
+	}
+}
+
+// update replaces the block at old with a block of the given size at p.
+func (c *countingAllocator) update(old, p uintptr, size int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if old != 0 {
+		c.live -= c.blocks[old]
+		delete(c.blocks, old)
+	}
+	if p != 0 {
+		c.live += size
+		c.blocks[p] = size
+	}
+}
+
+func (c *countingAllocator) stats() (live int, released bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.live, c.released
+}
+
+func TestCreateAllocator(t *testing.T) {
+	var c countingAllocator
+	alloc, ok := corefoundation.CreateAllocator(corefoundation.AllocatorDefault(), c.callbacks())
+	if !ok {
+		t.Fatal("failed to create allocator")
+	}
+
+	m, ok := corefoundation.CreateMutableData(alloc, 0)
+	if !ok {
+		t.Fatal("failed to create mutable data")
+	}
+	corefoundation.AppendDataBytes(m, make([]byte, 1<<16))
+	if live, _ := c.stats(); live < 1<<16 {
+		t.Errorf("expected at least %d live bytes, got %d", 1<<16, live)
+	}
+	corefoundation.Release(m)
+	if live, _ := c.stats(); live != 0 {
+		t.Errorf("expected no live bytes after release, got %d", live)
+	}
+
+	if _, released := c.stats(); released {
+		t.Fatal("allocator released while referenced")
+	}
+	corefoundation.Release(alloc)
+	if _, released := c.stats(); !released {
+		t.Error("expected Release callback to be called")
+	}
+}
+
+func TestCreateAllocatorNilCallbacks(t *testing.T) {
+	alloc, ok := corefoundation.CreateAllocator(corefoundation.AllocatorDefault(), nil)
+	if !ok {
+		t.Fatal("failed to create allocator")
+	}
+	defer corefoundation.Release(alloc)
+
+	if p := corefoundation.AllocatorAllocate(alloc, 16, 0); p != 0 {
+		t.Errorf("expected allocation to fail, got %#x", p)
+	}
+}
+
+func TestCreateAllocatorUseContext(t *testing.T) {
+	var c countingAllocator
+	alloc, ok := corefoundation.CreateAllocator(corefoundation.AllocatorUseContext(), c.callbacks())
+	if !ok {
+		t.Fatal("failed to create allocator")
+	}
+	if live, _ := c.stats(); live == 0 {
+		t.Error("expected the allocator to allocate itself")
+	}
+	corefoundation.Release(alloc)
+	if live, released := c.stats(); live != 0 || !released {
+		t.Errorf("expected allocator to deallocate itself, got %d live bytes, released %v", live, released)
+	}
+}
+
+func TestArenaAllocator(t *testing.T) {
+	parent := corefoundation.AllocatorMalloc()
+	var blocks []uintptr
+	freed := false
+	alloc, ok := corefoundation.CreateAllocator(corefoundation.AllocatorDefault(), &corefoundation.AllocatorCallbacks{
+		Allocate: func(size int, hint uint) uintptr {
+			p := corefoundation.AllocatorAllocate(parent, size, hint)
+			blocks = append(blocks, p)
+			return p
+		},
+		Release: func() {
+			// Objects retain their allocator, so all of them are
+			// deallocated when the arena is released.
+			for _, p := range blocks {
+				corefoundation.AllocatorDeallocate(parent, p)
+			}
+			blocks, freed = nil, true
+		},
+	})
+	if !ok {
+		t.Fatal("failed to create allocator")
+	}
+
+	for i := 0; i < 10; i++ {
+		d, ok := corefoundation.CreateData(alloc, []byte("temporary"))
+		if !ok {
+			t.Fatal("failed to create data")
+		}
+		corefoundation.Release(d)
+	}
+	if len(blocks) < 10 {
+		t.Errorf("expected at least 10 blocks, got %d", len(blocks))
+	}
+	if freed {
+		t.Fatal("arena freed while referenced")
+	}
+	corefoundation.Release(alloc)
+	if !freed {
+		t.Error("expected arena to be freed")
+	}
+}
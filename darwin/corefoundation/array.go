@@ -9,6 +9,11 @@ package corefoundation
 //  • https://developer.apple.com/documentation/corefoundation/cfarray-s28
 
 import (
+	"sort"
+	"sync"
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/callback"
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -20,14 +25,45 @@ type Array types.CFArray
 
 // ArrayCallbacks is a structure containing the callbacks of an Array.
 //
+// Values of arrays with custom callbacks are opaque pointer-sized values,
+// e.g. raw pointers to unmanaged memory or handles to Go values, and the
+// callbacks define retain, release, description and equality semantics for
+// them. A nil callback has the same meaning as NULL function pointer in C.
+//
+// Callbacks are called from C using internal/callback package and exist as
+// long as any array created with the ArrayCallbacks value. That is, the array
+// is created with an allocator that forwards requests to the given allocator
+// and releases the callbacks when the array is deallocated. Copies created
+// with CreateArrayCopy and CreateMutableArrayCopy extend the lifetime of
+// callbacks in the same way, but copies created by C code, e.g. with
+// CFArrayCreateCopy, do not, and must not outlive the arrays created in Go.
+//
+// Since callbacks can only be called on threads created by Go, arrays with
+// custom callbacks must not be released on other threads, e.g. by dispatch
+// queues. Otherwise the callbacks are not called and remain allocated.
+//
+// Fields must not be modified after the first use of an ArrayCallbacks value.
+//
 // References
 //  • https://developer.apple.com/documentation/corefoundation/cfarraycallbacks
 type ArrayCallbacks struct {
-	// TODO: somehow wrap C functions that do accept context.
-	// Perhaps we can use the Allocator’s context user info?
+	// Retain is called when a value is added to the array. It returns the
+	// value to store in the array, usually v itself.
+	Retain func(alloc Allocator, v uintptr) uintptr
+	// Release is called when a value is removed from the array.
+	Release func(alloc Allocator, v uintptr)
+	// CopyDescription returns a textual description of the value. The
+	// caller owns the returned string.
+	CopyDescription func(v uintptr) String
+	// Equal reports whether two values are equal. If it is nil, values
+	// are compared by identity.
+	Equal func(a, b uintptr) bool
 }
 
-var arrayCallbacksForObject ArrayCallbacks
+var (
+	arrayCallbacksForObject ArrayCallbacks
+	arrayCallbacksNone      ArrayCallbacks
+)
 
 // ArrayCallbacksForObject returns an ArrayCallbacks appropriate for use when
 // the values in an Array are all Core Foundation objects.
@@ -37,3 +73,343 @@ var arrayCallbacksForObject ArrayCallbacks
 func ArrayCallbacksForObject() *ArrayCallbacks {
 	return &arrayCallbacksForObject
 }
+
+// ArrayCallbacksNone returns an ArrayCallbacks for arrays of raw pointer
+// values. Values are neither retained nor released, and are compared by
+// identity. It is equivalent to nil callbacks.
+func ArrayCallbacksNone() *ArrayCallbacks {
+	return &arrayCallbacksNone
+}
+
+// cfArrayCallbacks is the CFArrayCallBacks structure.
+type cfArrayCallbacks struct {
+	Version         int
+	Retain          uintptr
+	Release         uintptr
+	CopyDescription uintptr
+	Equal           uintptr
+}
+
+// arrayCallbacksRef is a reference counted C representation of custom
+// ArrayCallbacks.
+type arrayCallbacksRef struct {
+	refs  int
+	c     cfArrayCallbacks
+	slots []callbackSlot
+}
+
+// arrayCallbacks maps custom ArrayCallbacks values to their C representation
+// while there are arrays that use them, and addresses of forwarding
+// allocators of such arrays to the callbacks.
+var arrayCallbacks struct {
+	sync.Mutex
+	m      map[*ArrayCallbacks]*arrayCallbacksRef
+	allocs map[uintptr]*ArrayCallbacks
+}
+
+// acquire returns the allocator and a pointer to CFArrayCallBacks structure to
+// create an array with. The caller must call release function after creating
+// the array. It returns false if there are no free callback slots.
+func (c *ArrayCallbacks) acquire(alloc Allocator) (Allocator, unsafe.Pointer, func(), bool) {
+	switch c {
+	case nil, ArrayCallbacksNone():
+		return alloc, nil, func() {}, true
+	case ArrayCallbacksForObject():
+		return alloc, typeArrayCallbacks(), func() {}, true
+	}
+
+	ref, ok := c.ref()
+	if !ok {
+		return nil, nil, nil, false
+	}
+	var addr uintptr
+	wrapper, ok := createForwardingAllocator(alloc, func() {
+		arrayCallbacks.Lock()
+		delete(arrayCallbacks.allocs, addr)
+		arrayCallbacks.Unlock()
+		c.unref()
+	})
+	if !ok {
+		c.unref()
+		return nil, nil, nil, false
+	}
+
+	arrayCallbacks.Lock()
+	addr = objectAddr(wrapper)
+	if arrayCallbacks.allocs == nil {
+		arrayCallbacks.allocs = make(map[uintptr]*ArrayCallbacks)
+	}
+	arrayCallbacks.allocs[addr] = c
+	arrayCallbacks.Unlock()
+
+	return wrapper, unsafe.Pointer(&ref.c), func() { Release(wrapper) }, true
+}
+
+// ref increments the reference count of the C representation of callbacks
+// and creates it on the first use.
+func (c *ArrayCallbacks) ref() (*arrayCallbacksRef, bool) {
+	arrayCallbacks.Lock()
+	defer arrayCallbacks.Unlock()
+	if ref, ok := arrayCallbacks.m[c]; ok {
+		ref.refs++
+		return ref, true
+	}
+	ref := &arrayCallbacksRef{refs: 1}
+	if !ref.init(*c) {
+		return nil, false
+	}
+	if arrayCallbacks.m == nil {
+		arrayCallbacks.m = make(map[*ArrayCallbacks]*arrayCallbacksRef)
+	}
+	arrayCallbacks.m[c] = ref
+	return ref, true
+}
+
+// unref decrements the reference count and frees callbacks when it reaches
+// zero.
+func (c *ArrayCallbacks) unref() {
+	arrayCallbacks.Lock()
+	defer arrayCallbacks.Unlock()
+	ref := arrayCallbacks.m[c]
+	ref.refs--
+	if ref.refs == 0 {
+		delete(arrayCallbacks.m, c)
+		freeCallbacks(ref.slots)
+	}
+}
+
+// arrayCallbacksOf returns custom callbacks of an array created in Go, or nil
+// if the array has no custom callbacks or was created by C code.
+func arrayCallbacksOf(a Array) *ArrayCallbacks {
+	addr := objectAddr(cfGetAllocator(a))
+	arrayCallbacks.Lock()
+	defer arrayCallbacks.Unlock()
+	return arrayCallbacks.allocs[addr]
+}
+
+// init creates C function pointers for non-nil callbacks in cb.
+func (ref *arrayCallbacksRef) init(cb ArrayCallbacks) bool {
+	var slots []callbackSlot
+	if cb.Retain != nil {
+		// const void *retain(CFAllocatorRef allocator, const void *value)
+		slots = append(slots, callbackSlot{&ref.c.Retain, func(args callback.Args) uintptr {
+			return cb.Retain(types.Pointer(args[0]), args[1])
+		}})
+	}
+	if cb.Release != nil {
+		// void release(CFAllocatorRef allocator, const void *value)
+		slots = append(slots, callbackSlot{&ref.c.Release, func(args callback.Args) uintptr {
+			cb.Release(types.Pointer(args[0]), args[1])
+			return 0
+		}})
+	}
+	if cb.CopyDescription != nil {
+		// CFStringRef copyDescription(const void *value)
+		slots = append(slots, callbackSlot{&ref.c.CopyDescription, func(args callback.Args) uintptr {
+			return objectAddr(cb.CopyDescription(args[0]))
+		}})
+	}
+	if cb.Equal != nil {
+		// Boolean equal(const void *value1, const void *value2)
+		slots = append(slots, callbackSlot{&ref.c.Equal, func(args callback.Args) uintptr {
+			if cb.Equal(args[0], args[1]) {
+				return 1
+			}
+			return 0
+		}})
+	}
+	if err := newCallbacks(slots); err != nil {
+		return false
+	}
+	ref.slots = slots
+	return true
+}
+
+// objectAddr returns the address of the object or zero if v is nil.
+func objectAddr(v Object) uintptr {
+	if v == nil {
+		return 0
+	}
+	return v.Pointer()
+}
+
+// CreateArray creates an immutable array containing the given values.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraycreate(_:_:_:_:)
+func CreateArray(alloc Allocator, values []Object, callbacks *ArrayCallbacks) (Array, bool) {
+	var valuesPtr unsafe.Pointer
+	if len(values) > 0 {
+		addrs := make([]uintptr, len(values))
+		for i, v := range values {
+			addrs[i] = objectAddr(v)
+		}
+		valuesPtr = unsafe.Pointer(&addrs[0])
+	}
+
+	alloc, callbacksPtr, release, ok := callbacks.acquire(alloc)
+	if !ok {
+		return nil, false
+	}
+	defer release()
+
+	out := cfArrayCreate(alloc, valuesPtr, len(values), callbacksPtr)
+	return out, out != 0
+}
+
+// CreateArrayCopy creates an immutable array with the same values and
+// callbacks as the given array.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraycreatecopy(_:_:)
+func CreateArrayCopy(alloc Allocator, a Array) (Array, bool) {
+	alloc, _, release, ok := arrayCallbacksOf(a).acquire(alloc)
+	if !ok {
+		return nil, false
+	}
+	defer release()
+
+	out := cfArrayCreateCopy(alloc, a)
+	return out, out != 0
+}
+
+// CreateArrayFromStrings creates an immutable array of String objects with
+// the given UTF-8 encoded contents.
+//
+// If there was a problem creating the array or any of the strings, it returns
+// false.
+func CreateArrayFromStrings(alloc Allocator, ss []string) (Array, bool) {
+	values := make([]Object, 0, len(ss))
+	defer func() {
+		for _, v := range values {
+			Release(v)
+		}
+	}()
+	for _, s := range ss {
+		v, ok := CreateStringWithBytes(alloc, []byte(s), StringEncodingUTF8, false)
+		if !ok {
+			return nil, false
+		}
+		values = append(values, v)
+	}
+	return CreateArray(alloc, values, ArrayCallbacksForObject())
+}
+
+// ArrayCount returns the number of values currently in an array.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraygetcount(_:)
+func ArrayCount(a Array) int {
+	return cfArrayGetCount(a)
+}
+
+// ArrayValueAt returns the value at the given index in an array. The index
+// must be in the range [0, ArrayCount(a)).
+//
+// The array retains the ownership of the returned value.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraygetvalueatindex(_:_:)
+func ArrayValueAt(a Array, i int) Object {
+	return types.Pointer(cfArrayGetValueAtIndex(a, i))
+}
+
+// ArrayValues returns the values in the given range of an array. The range
+// must be within the bounds of the array.
+//
+// The array retains the ownership of the returned values.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraygetvalues(_:_:_:)
+func ArrayValues(a Array, r Range) []Object {
+	if r.Length <= 0 {
+		return nil
+	}
+	addrs := make([]uintptr, r.Length)
+	cfArrayGetValues(a, r.Location, r.Length, unsafe.Pointer(&addrs[0]))
+
+	values := make([]Object, len(addrs))
+	for i, addr := range addrs {
+		values[i] = types.Pointer(addr)
+	}
+	return values
+}
+
+// ArrayContainsValue reports whether a value is in the given range of an
+// array. Values are compared using the equality callback of the array, i.e.
+// Equal for arrays created with ArrayCallbacksForObject.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraycontainsvalue(_:_:_:)
+func ArrayContainsValue(a Array, r Range, v Object) bool {
+	return cfArrayContainsValue(a, r.Location, r.Length, objectAddr(v))
+}
+
+// ArrayIndexOf returns the index of the first occurrence of a value in the
+// given range of an array, or -1 if the value is not found.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraygetfirstindexofvalue(_:_:_:)
+func ArrayIndexOf(a Array, r Range, v Object) int {
+	i := cfArrayGetFirstIndexOfValue(a, r.Location, r.Length, objectAddr(v))
+	if i == notFound {
+		return -1
+	}
+	return i
+}
+
+// SortArray sorts the values in the given range of an array using the less
+// function. The sort is stable.
+//
+// Unlike CFArraySortValues, it calls less from Go and reorders the values by
+// exchanging them, so that the callbacks of the array are not invoked.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraysortvalues(_:_:_:_:)
+//  • https://developer.apple.com/documentation/corefoundation/cfarrayexchangevaluesatindices(_:_:_:)
+func SortArray(m MutableArray, r Range, less func(a, b Object) bool) {
+	values := ArrayValues(m, r)
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(values[order[i]], values[order[j]])
+	})
+
+	// pos[k] is the current position of values[k], and at[i] is the index
+	// of the value at position i.
+	pos := make([]int, len(values))
+	at := make([]int, len(values))
+	for i := range pos {
+		pos[i], at[i] = i, i
+	}
+	for i, k := range order {
+		j := pos[k]
+		if j == i {
+			continue
+		}
+		cfArrayExchangeValuesAtIndices(m, r.Location+i, r.Location+j)
+		at[i], at[j] = at[j], at[i]
+		pos[at[i]], pos[at[j]] = i, j
+	}
+}
+
+// ApplyToArray calls fn for each value in the given range of an array.
+//
+// The values are read before the first call, so fn may add values to the
+// array. The array retains the ownership of the values passed to fn, so fn
+// must not remove or replace values in the given range, since the array may
+// release them before fn is called with them.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarrayapplyfunction(_:_:_:_:)
+func ApplyToArray(a Array, r Range, fn func(v Object)) {
+	for _, v := range ArrayValues(a, r) {
+		fn(v)
+	}
+}
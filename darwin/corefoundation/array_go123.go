@@ -0,0 +1,22 @@
+//go:build darwin && go1.23
+// +build darwin,go1.23
+
+package corefoundation
+
+import (
+	"iter"
+)
+
+// All returns an iterator over the values in an array.
+//
+// The array must not be modified during iteration.
+func All(a Array) iter.Seq[Object] {
+	return func(yield func(Object) bool) {
+		n := ArrayCount(a)
+		for i := 0; i < n; i++ {
+			if !yield(ArrayValueAt(a, i)) {
+				return
+			}
+		}
+	}
+}
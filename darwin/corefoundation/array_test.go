@@ -0,0 +1,198 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+func TestCreateArrayFromStrings(t *testing.T) {
+	ss := []string{"c", "a", "b"}
+	a, ok := corefoundation.CreateArrayFromStrings(corefoundation.AllocatorDefault(), ss)
+	if !ok {
+		t.Fatal("failed to create an array")
+	}
+	defer corefoundation.Release(a)
+
+	if n := corefoundation.ArrayCount(a); n != len(ss) {
+		t.Fatalf("expected %d values, got %d", len(ss), n)
+	}
+	all := corefoundation.Range{Length: len(ss)}
+	values := corefoundation.ArrayValues(a, all)
+	for i, v := range values {
+		if w := corefoundation.ArrayValueAt(a, i); w.Pointer() != v.Pointer() {
+			t.Errorf("value %d: ArrayValueAt and ArrayValues differ", i)
+		}
+		if _, ok := corefoundation.AsString(v); !ok {
+			t.Errorf("value %d is not a string", i)
+		}
+		if j := corefoundation.ArrayIndexOf(a, all, v); j != i {
+			t.Errorf("expected index %d, got %d", i, j)
+		}
+		if !corefoundation.ArrayContainsValue(a, all, v) {
+			t.Errorf("array should contain value %d", i)
+		}
+	}
+	if corefoundation.ArrayContainsValue(a, corefoundation.Range{Location: 1, Length: 2}, values[0]) {
+		t.Error("range should not contain the first value")
+	}
+	if i := corefoundation.ArrayIndexOf(a, all, a); i != -1 {
+		t.Errorf("expected index -1 for missing value, got %d", i)
+	}
+}
+
+func TestSortArray(t *testing.T) {
+	ss := []string{"d", "b", "a", "c"}
+	m, ok := corefoundation.CreateMutableArray(
+		corefoundation.AllocatorDefault(),
+		0,
+		corefoundation.ArrayCallbacksForObject(),
+	)
+	if !ok {
+		t.Fatal("failed to create a mutable array")
+	}
+	defer corefoundation.Release(m)
+
+	names := make(map[uintptr]string)
+	for _, s := range ss {
+		v, ok := corefoundation.CreateStringWithBytes(
+			corefoundation.AllocatorDefault(),
+			[]byte(s),
+			corefoundation.StringEncodingUTF8,
+			false,
+		)
+		if !ok {
+			t.Fatal("failed to create a string")
+		}
+		names[v.Pointer()] = s
+		corefoundation.AppendToArray(m, v)
+		corefoundation.Release(v)
+	}
+
+	all := corefoundation.Range{Length: len(ss)}
+	corefoundation.SortArray(m, all, func(a, b corefoundation.Object) bool {
+		return names[a.Pointer()] < names[b.Pointer()]
+	})
+
+	var got string
+	corefoundation.ApplyToArray(m, all, func(v corefoundation.Object) {
+		got += names[v.Pointer()]
+	})
+	if got != "abcd" {
+		t.Fatalf("expected sorted values %q, got %q", "abcd", got)
+	}
+}
+
+func TestArrayCallbacks(t *testing.T) {
+	refs := make(map[uintptr]int)
+	callbacks := &corefoundation.ArrayCallbacks{
+		Retain: func(_ corefoundation.Allocator, v uintptr) uintptr {
+			refs[v]++
+			return v
+		},
+		Release: func(_ corefoundation.Allocator, v uintptr) {
+			refs[v]--
+		},
+		Equal: func(a, b uintptr) bool {
+			return a%10 == b%10
+		},
+	}
+
+	m, ok := corefoundation.CreateMutableArray(corefoundation.AllocatorDefault(), 0, callbacks)
+	if !ok {
+		t.Fatal("failed to create a mutable array")
+	}
+	for _, v := range []uintptr{1, 2, 3} {
+		corefoundation.AppendToArray(m, types.Pointer(v))
+	}
+	if refs[1] != 1 || refs[2] != 1 || refs[3] != 1 {
+		t.Errorf("values should be retained once: %v", refs)
+	}
+	all := corefoundation.Range{Length: corefoundation.ArrayCount(m)}
+	if i := corefoundation.ArrayIndexOf(m, all, types.Pointer(12)); i != 1 {
+		t.Errorf("expected index 1 using Equal callback, got %d", i)
+	}
+
+	a, ok := corefoundation.CreateArray(
+		corefoundation.AllocatorDefault(),
+		corefoundation.ArrayValues(m, all),
+		callbacks,
+	)
+	if !ok {
+		t.Fatal("failed to create an array")
+	}
+	if refs[1] != 2 {
+		t.Errorf("values should be retained by both arrays: %v", refs)
+	}
+
+	corefoundation.Release(m)
+	corefoundation.Release(a)
+	for v, n := range refs {
+		if n != 0 {
+			t.Errorf("value %d has %d references after release", v, n)
+		}
+	}
+}
+
+func TestCreateArrayCopyCallbacks(t *testing.T) {
+	released := 0
+	callbacks := &corefoundation.ArrayCallbacks{
+		Release: func(_ corefoundation.Allocator, v uintptr) {
+			released++
+		},
+	}
+	m, ok := corefoundation.CreateMutableArray(corefoundation.AllocatorDefault(), 0, callbacks)
+	if !ok {
+		t.Fatal("failed to create a mutable array")
+	}
+	corefoundation.AppendToArray(m, types.Pointer(1))
+
+	a, ok := corefoundation.CreateArrayCopy(corefoundation.AllocatorDefault(), m)
+	if !ok {
+		t.Fatal("failed to copy an array")
+	}
+	mc, ok := corefoundation.CreateMutableArrayCopy(corefoundation.AllocatorDefault(), 0, a)
+	if !ok {
+		t.Fatal("failed to create a mutable copy of an array")
+	}
+	corefoundation.Release(m)
+	corefoundation.Release(a)
+
+	// Callbacks of released arrays must stay valid for the copy even if
+	// other callbacks are created in the meantime.
+	other := &corefoundation.ArrayCallbacks{
+		Release: func(_ corefoundation.Allocator, v uintptr) {
+			t.Errorf("unexpected release of value %d with other callbacks", v)
+		},
+	}
+	o, ok := corefoundation.CreateArray(corefoundation.AllocatorDefault(), nil, other)
+	if !ok {
+		t.Fatal("failed to create an array")
+	}
+	defer corefoundation.Release(o)
+
+	corefoundation.Release(mc)
+	if released != 3 {
+		t.Errorf("expected 3 releases by the array and its copies, got %d", released)
+	}
+}
+
+func TestArrayCallbacksNone(t *testing.T) {
+	a, ok := corefoundation.CreateArray(
+		corefoundation.AllocatorDefault(),
+		[]corefoundation.Object{types.Pointer(1), types.Pointer(2)},
+		corefoundation.ArrayCallbacksNone(),
+	)
+	if !ok {
+		t.Fatal("failed to create an array")
+	}
+	defer corefoundation.Release(a)
+
+	if v := corefoundation.ArrayValueAt(a, 1); v.Pointer() != 2 {
+		t.Errorf("expected raw value 2, got %d", v.Pointer())
+	}
+}
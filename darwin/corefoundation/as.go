@@ -0,0 +1,30 @@
+//go:build darwin && go1.18
+// +build darwin,go1.18
+
+package corefoundation
+
+import (
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// As returns v as T if v is an instance of the opaque type that T represents,
+// e.g. As[String] is equivalent to AsString. If T is Object, it returns v and
+// true for any non-nil object.
+//
+// It returns false if v is nil, has a different type or if T has no type
+// identifier, e.g. MutableArray that shares the type identifier with Array.
+func As[T Object](v Object) (T, bool) {
+	var zero T
+	if v == nil || v.Pointer() == 0 {
+		return zero, false
+	}
+	if p, ok := any(&zero).(*Object); ok {
+		*p = v
+		return zero, true
+	}
+	id, ok := typeIDOf(&zero)
+	if !ok || GetTypeID(v) != id {
+		return zero, false
+	}
+	return any(types.Pointer(v.Pointer())).(T), true
+}
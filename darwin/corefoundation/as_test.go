@@ -0,0 +1,45 @@
+//go:build darwin && go1.18
+// +build darwin,go1.18
+
+package corefoundation_test
+
+import (
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestAs(t *testing.T) {
+	s, ok := corefoundation.CreateStringWithBytes(
+		corefoundation.AllocatorDefault(),
+		[]byte("example"),
+		corefoundation.StringEncodingUTF8,
+		false,
+	)
+	if !ok {
+		t.Fatal("failed to create a string")
+	}
+	defer corefoundation.Release(s)
+
+	if _, ok := corefoundation.AsString(s); !ok {
+		t.Error("AsString should accept a string")
+	}
+	if _, ok := corefoundation.AsArray(s); ok {
+		t.Error("AsArray should reject a string")
+	}
+	if _, ok := corefoundation.AsString(nil); ok {
+		t.Error("AsString should reject nil")
+	}
+	if _, ok := corefoundation.As[corefoundation.String](s); !ok {
+		t.Error("As[String] should accept a string")
+	}
+	if _, ok := corefoundation.As[corefoundation.Data](s); ok {
+		t.Error("As[Data] should reject a string")
+	}
+	if _, ok := corefoundation.As[corefoundation.MutableArray](s); ok {
+		t.Error("As[MutableArray] should reject a string")
+	}
+	if v, ok := corefoundation.As[corefoundation.Object](s); !ok || v.Pointer() != s.Pointer() {
+		t.Error("As[Object] should return the object")
+	}
+}
@@ -0,0 +1,36 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFBoolean APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfboolean
+
+import (
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// Boolean is an opaque reference to a CFBoolean type. There are only two
+// Boolean values, BooleanTrue and BooleanFalse.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfbooleanref
+type Boolean types.CFBoolean
+
+// BooleanOf returns BooleanTrue or BooleanFalse for the given value.
+func BooleanOf(v bool) Boolean {
+	if v {
+		return BooleanTrue()
+	}
+	return BooleanFalse()
+}
+
+// BooleanValue returns the value of a Boolean.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfbooleangetvalue(_:)
+func BooleanValue(b Boolean) bool {
+	return cfBooleanGetValue(b)
+}
@@ -0,0 +1,35 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestBoolean(t *testing.T) {
+	for _, v := range []bool{false, true} {
+		b := corefoundation.BooleanOf(v)
+		if got := corefoundation.BooleanValue(b); got != v {
+			t.Errorf("expected %v, got %v", v, got)
+		}
+		if _, ok := corefoundation.AsBoolean(b); !ok {
+			t.Errorf("%v is not a Boolean", v)
+		}
+	}
+	if corefoundation.Equal(corefoundation.BooleanTrue(), corefoundation.BooleanFalse()) {
+		t.Error("true and false should not be equal")
+	}
+}
+
+func TestNull(t *testing.T) {
+	null := corefoundation.Null()
+	if _, ok := corefoundation.AsNullObject(null); !ok {
+		t.Error("Null is not a NullObject")
+	}
+	if _, ok := corefoundation.AsNullObject(corefoundation.BooleanFalse()); ok {
+		t.Error("BooleanFalse should not be a NullObject")
+	}
+}
@@ -0,0 +1,76 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+import (
+	"sync"
+
+	"github.com/noncgo/x/darwin/internal/callback"
+)
+
+// callbackSlot is a Go implementation of a C callback and the location for
+// its function pointer, e.g. a field in a C structure.
+type callbackSlot struct {
+	addr *uintptr
+	fn   callback.Func
+}
+
+// newCallbacks creates C function pointers for the slots. On error, it frees
+// function pointers that were already created.
+func newCallbacks(slots []callbackSlot) error {
+	for i, s := range slots {
+		addr, err := callback.New(s.fn)
+		if err != nil {
+			freeCallbacks(slots[:i])
+			return err
+		}
+		*s.addr = addr
+	}
+	return nil
+}
+
+// freeCallbacks frees C function pointers for the slots.
+func freeCallbacks(slots []callbackSlot) {
+	for _, s := range slots {
+		callback.Free(*s.addr)
+		*s.addr = 0
+	}
+}
+
+// contextInfos maps info values of C context structures, e.g.
+// CFAllocatorContext, to Go state. Info values are keys rather than Go
+// pointers since C memory must not hold Go pointers.
+type contextInfos struct {
+	sync.Mutex
+	next uintptr
+	m    map[uintptr]interface{}
+}
+
+// add stores v and returns a new info value for it.
+func (c *contextInfos) add(v interface{}) uintptr {
+	c.Lock()
+	defer c.Unlock()
+	c.next++
+	if c.m == nil {
+		c.m = make(map[uintptr]interface{})
+	}
+	c.m[c.next] = v
+	return c.next
+}
+
+// get returns the value for info.
+func (c *contextInfos) get(info uintptr) interface{} {
+	c.Lock()
+	defer c.Unlock()
+	return c.m[info]
+}
+
+// remove deletes the value for info and returns it.
+func (c *contextInfos) remove(info uintptr) interface{} {
+	c.Lock()
+	defer c.Unlock()
+	v := c.m[info]
+	delete(c.m, info)
+	return v
+}
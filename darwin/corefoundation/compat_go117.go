@@ -0,0 +1,8 @@
+//go:build darwin && !go1.18
+// +build darwin,!go1.18
+
+package corefoundation
+
+// any is an alias for interface{} and is equivalent to interface{} in all
+// ways.
+type any = interface{}
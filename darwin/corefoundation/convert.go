@@ -0,0 +1,563 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file implements conversion between Go values and property list objects,
+// i.e. String, Data, Boolean, Number, Date, Array and Dictionary.
+//
+// References
+//  • https://developer.apple.com/library/archive/documentation/CoreFoundation/Conceptual/CFPropertyLists/CFPropertyLists.html
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+var (
+	objectType = reflect.TypeOf((*Object)(nil)).Elem()
+	timeType   = reflect.TypeOf(time.Time{})
+)
+
+// UnsupportedTypeError is returned by FromGo when attempting to convert a Go
+// value of unsupported type.
+type UnsupportedTypeError struct {
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *UnsupportedTypeError) Error() string {
+	return "corefoundation: unsupported type: " + e.Type.String()
+}
+
+// UnsupportedValueError is returned by FromGo when attempting to convert an
+// unsupported value, e.g. a cyclic data structure or an unsigned integer that
+// does not fit in a Number.
+type UnsupportedValueError struct {
+	Value reflect.Value
+	Str   string
+}
+
+// Error implements the error interface.
+func (e *UnsupportedValueError) Error() string {
+	return "corefoundation: unsupported value: " + e.Str
+}
+
+// UnsupportedObjectError is returned by ToGo when attempting to convert an
+// object that is not a property list object or a property list object that
+// cannot be represented in Go, e.g. a cyclic array.
+type UnsupportedObjectError struct {
+	TypeID TypeID
+	Str    string
+}
+
+// Error implements the error interface.
+func (e *UnsupportedObjectError) Error() string {
+	return "corefoundation: unsupported object: " + e.Str
+}
+
+// createError is returned when Core Foundation fails to create an object.
+type createError struct {
+	what string
+}
+
+func (e *createError) Error() string {
+	return "corefoundation: failed to create " + e.what
+}
+
+// FromGo returns a property list object for the Go value v. The caller owns
+// the returned reference.
+//
+// FromGo converts values as follows, in the spirit of encoding/json:
+//  • nil pointers, interfaces, slices and maps to Null;
+//  • Object values to themselves, with the reference retained;
+//  • strings to String, replacing invalid UTF-8 as NewString does;
+//  • byte slices to Data;
+//  • booleans to Boolean;
+//  • integers to Number of the smallest signed type that holds all values of
+//    the Go type, an unsigned 64-bit value above math.MaxInt64 is an error;
+//  • floating point numbers to Number of Float32 or Float64 type;
+//  • time.Time to Date;
+//  • other slices and arrays to Array;
+//  • maps with string keys to Dictionary;
+//  • structs to Dictionary.
+//
+// Pointers and interfaces are converted as the value they point to. Each
+// exported struct field becomes a dictionary key, unless the field’s tag is
+// "-". The “cf” key in the struct field’s tag value is the key name, followed
+// by an optional comma and options. The “omitempty” option omits the field if
+// it has an empty value as defined by encoding/json. Exported anonymous struct
+// fields without a name in the tag are flattened into the parent dictionary,
+// with fields of the parent taking precedence. This includes embedded pointers
+// to structs, unless they are nil.
+//
+// FromGo returns UnsupportedTypeError for channels, functions, complex numbers
+// and maps with non-string keys, and UnsupportedValueError for cyclic data
+// structures.
+func FromGo(v any) (Object, error) {
+	e := encoder{visiting: make(map[visitKey]bool)}
+	return e.encode(reflect.ValueOf(v))
+}
+
+// visitKey identifies a pointer, map or slice value for cycle detection.
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// encoder converts Go values to property list objects.
+type encoder struct {
+	// visiting is the set of pointers, maps and slices on the path from
+	// the root value.
+	visiting map[visitKey]bool
+}
+
+func (e *encoder) encode(v reflect.Value) (Object, error) {
+	if !v.IsValid() {
+		return Retain(Null()), nil
+	}
+	if v.Type().Implements(objectType) {
+		if v.Kind() == reflect.Interface && v.IsNil() {
+			return Retain(Null()), nil
+		}
+		obj := v.Interface().(Object)
+		if obj.Pointer() == 0 {
+			return Retain(Null()), nil
+		}
+		return Retain(obj), nil
+	}
+	if v.Type() == timeType {
+		d, ok := CreateDate(AllocatorDefault(), v.Interface().(time.Time))
+		if !ok {
+			return nil, &createError{"date"}
+		}
+		return d, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return Retain(Null()), nil
+		}
+		return e.encode(v.Elem())
+	case reflect.Ptr:
+		if v.IsNil() {
+			return Retain(Null()), nil
+		}
+		leave, err := e.enter(v, 0)
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		return e.encode(v.Elem())
+	case reflect.String:
+		s := NewString(v.String())
+		if s == nil {
+			return nil, &createError{"string"}
+		}
+		return s, nil
+	case reflect.Bool:
+		return Retain(BooleanOf(v.Bool())), nil
+	case reflect.Int8:
+		return e.number(NumberSInt8Type, v.Int())
+	case reflect.Int16, reflect.Uint8:
+		return e.number(NumberSInt16Type, intValue(v))
+	case reflect.Int32, reflect.Uint16:
+		return e.number(NumberSInt32Type, intValue(v))
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		return e.number(NumberSInt64Type, intValue(v))
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		if v.Uint() > math.MaxInt64 {
+			return nil, &UnsupportedValueError{v, fmt.Sprintf("%d overflows Number", v.Uint())}
+		}
+		return e.number(NumberSInt64Type, int64(v.Uint()))
+	case reflect.Float32:
+		return e.float(NumberFloat32Type, v.Float())
+	case reflect.Float64:
+		return e.float(NumberFloat64Type, v.Float())
+	case reflect.Slice:
+		if v.IsNil() {
+			return Retain(Null()), nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return e.data(v.Bytes())
+		}
+		leave, err := e.enter(v, v.Len())
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		return e.array(v)
+	case reflect.Array:
+		return e.array(v)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, &UnsupportedTypeError{v.Type()}
+		}
+		if v.IsNil() {
+			return Retain(Null()), nil
+		}
+		leave, err := e.enter(v, 0)
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		return e.dictionary(v)
+	case reflect.Struct:
+		return e.structure(v)
+	}
+	return nil, &UnsupportedTypeError{v.Type()}
+}
+
+// intValue returns the value of a signed or unsigned integer that fits in
+// int64.
+func intValue(v reflect.Value) int64 {
+	switch v.Kind() {
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return int64(v.Uint())
+	}
+	return v.Int()
+}
+
+// enter marks the pointer, map or slice v as being converted and returns a
+// function that unmarks it. It returns UnsupportedValueError if v is already
+// being converted, i.e. the data structure is cyclic.
+func (e *encoder) enter(v reflect.Value, n int) (func(), error) {
+	k := visitKey{v.Pointer(), v.Type(), n}
+	if e.visiting[k] {
+		return nil, &UnsupportedValueError{v, "encountered a cycle via " + v.Type().String()}
+	}
+	e.visiting[k] = true
+	return func() { delete(e.visiting, k) }, nil
+}
+
+func (e *encoder) number(t NumberType, v int64) (Object, error) {
+	n, ok := CreateNumber(AllocatorDefault(), t, v)
+	if !ok {
+		return nil, &createError{"number"}
+	}
+	return n, nil
+}
+
+func (e *encoder) float(t NumberType, v float64) (Object, error) {
+	n, ok := CreateFloatNumber(AllocatorDefault(), t, v)
+	if !ok {
+		return nil, &createError{"number"}
+	}
+	return n, nil
+}
+
+func (e *encoder) data(b []byte) (Object, error) {
+	out := cfDataCreate(AllocatorDefault(), b, len(b))
+	if out == 0 {
+		return nil, &createError{"data"}
+	}
+	return out, nil
+}
+
+func (e *encoder) array(v reflect.Value) (Object, error) {
+	values := make([]Object, 0, v.Len())
+	defer func() {
+		for _, x := range values {
+			Release(x)
+		}
+	}()
+	for i := 0; i < v.Len(); i++ {
+		x, err := e.encode(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, x)
+	}
+	a, ok := CreateArray(AllocatorDefault(), values, ArrayCallbacksForObject())
+	if !ok {
+		return nil, &createError{"array"}
+	}
+	return a, nil
+}
+
+func (e *encoder) dictionary(v reflect.Value) (Object, error) {
+	var keys, values []Object
+	defer func() {
+		for i := range keys {
+			Release(keys[i])
+		}
+		for i := range values {
+			Release(values[i])
+		}
+	}()
+	iter := v.MapRange()
+	for iter.Next() {
+		x, err := e.encode(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, x)
+		k := NewString(iter.Key().String())
+		if k == nil {
+			return nil, &createError{"string"}
+		}
+		keys = append(keys, k)
+	}
+	d, ok := CreateDictionary(AllocatorDefault(), keys, values)
+	if !ok {
+		return nil, &createError{"dictionary"}
+	}
+	return d, nil
+}
+
+func (e *encoder) structure(v reflect.Value) (Object, error) {
+	m, ok := CreateMutableDictionary(AllocatorDefault(), 0)
+	if !ok {
+		return nil, &createError{"dictionary"}
+	}
+	if err := e.fields(m, v); err != nil {
+		Release(m)
+		return nil, err
+	}
+	d, ok := CreateDictionaryCopy(AllocatorDefault(), m)
+	Release(m)
+	if !ok {
+		return nil, &createError{"dictionary"}
+	}
+	return d, nil
+}
+
+// fields sets dictionary values for fields of the struct v. Fields of
+// anonymous structs are set first, so that fields of v take precedence.
+func (e *encoder) fields(m MutableDictionary, v reflect.Value) error {
+	t := v.Type()
+	var fields []int
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("cf")
+		if tag == "-" {
+			continue
+		}
+		name, _ := parseTag(tag)
+		if f.Anonymous && name == "" && isEmbeddedStruct(f.Type) {
+			// Exported fields of embedded structs are promoted even if
+			// the struct type is unexported.
+			if err := e.embedded(m, v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			// Unexported field.
+			continue
+		}
+		fields = append(fields, i)
+	}
+	for _, i := range fields {
+		f := t.Field(i)
+		name, omitEmpty := parseTag(f.Tag.Get("cf"))
+		if name == "" {
+			name = f.Name
+		}
+		fv := v.Field(i)
+		if omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		x, err := e.encode(fv)
+		if err != nil {
+			return err
+		}
+		k := NewString(name)
+		if k == nil {
+			Release(x)
+			return &createError{"string"}
+		}
+		SetDictionaryValue(m, k, x)
+		Release(k)
+		Release(x)
+	}
+	return nil
+}
+
+// isEmbeddedStruct reports whether fields of an anonymous field of type t are
+// flattened into the parent dictionary, i.e. t is a struct or a pointer to
+// a struct.
+func isEmbeddedStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+// embedded sets dictionary values for fields of the embedded struct or
+// pointer to a struct v. Nil pointers have no fields.
+func (e *encoder) embedded(m MutableDictionary, v reflect.Value) error {
+	if v.Kind() != reflect.Ptr {
+		return e.fields(m, v)
+	}
+	if v.IsNil() {
+		return nil
+	}
+	leave, err := e.enter(v, 0)
+	if err != nil {
+		return err
+	}
+	defer leave()
+	return e.fields(m, v.Elem())
+}
+
+// parseTag splits a struct field’s cf tag into its name and reports whether
+// it has omitempty option.
+func parseTag(tag string) (name string, omitEmpty bool) {
+	opts := strings.Split(tag, ",")
+	for _, opt := range opts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return opts[0], omitEmpty
+}
+
+// isEmptyValue reports whether v is empty as defined by encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+// ToGo returns the Go value of a property list object. The object retains the
+// ownership of the reference.
+//
+// ToGo converts objects as follows:
+//  • nil and Null to nil;
+//  • String to string;
+//  • Data to []byte with a copy of the bytes;
+//  • Boolean to bool;
+//  • Number to int64 for integer types and to float64 otherwise;
+//  • Date to time.Time;
+//  • Array to []any;
+//  • Dictionary with String keys to map[string]any.
+//
+// Unpaired UTF-16 surrogates in strings are replaced with U+FFFD replacement
+// character, except in dictionary keys, where distinct keys would collide.
+//
+// ToGo returns UnsupportedObjectError for other objects, dictionaries with
+// non-string keys or keys with unpaired surrogates, 128-bit integers and
+// cyclic collections.
+func ToGo(v Object) (any, error) {
+	d := decoder{visiting: make(map[uintptr]bool)}
+	return d.decode(v)
+}
+
+// decoder converts property list objects to Go values.
+type decoder struct {
+	// visiting is the set of collections on the path from the root object.
+	visiting map[uintptr]bool
+}
+
+func (d *decoder) decode(v Object) (any, error) {
+	if v == nil || v.Pointer() == 0 {
+		return nil, nil
+	}
+	p := types.Pointer(v.Pointer())
+	switch id := GetTypeID(v); id {
+	case NullObjectGetTypeID():
+		return nil, nil
+	case StringGetTypeID():
+		return GoString(p), nil
+	case DataGetTypeID():
+		return Bytes(p), nil
+	case BooleanGetTypeID():
+		return BooleanValue(p), nil
+	case NumberGetTypeID():
+		if NumberIsFloat(p) {
+			f, _ := NumberFloat64Value(p)
+			return f, nil
+		}
+		n, exact := NumberInt64Value(p)
+		if !exact {
+			return nil, &UnsupportedObjectError{id, "number overflows int64"}
+		}
+		return n, nil
+	case DateGetTypeID():
+		return DateTime(p), nil
+	case ArrayGetTypeID():
+		leave, err := d.enter(v, id)
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		values := ArrayValues(p, Range{Length: ArrayCount(p)})
+		xs := make([]any, len(values))
+		for i, value := range values {
+			x, err := d.decode(value)
+			if err != nil {
+				return nil, err
+			}
+			xs[i] = x
+		}
+		return xs, nil
+	case DictionaryGetTypeID():
+		leave, err := d.enter(v, id)
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		keys, values := DictionaryKeysAndValues(p)
+		m := make(map[string]any, len(keys))
+		for i, key := range keys {
+			k, ok := AsString(key)
+			if !ok {
+				return nil, &UnsupportedObjectError{id, "dictionary key of type " + typeName(GetTypeID(key))}
+			}
+			gk, ok := goString(k, false)
+			if !ok {
+				return nil, &UnsupportedObjectError{id, "dictionary key with unpaired surrogates"}
+			}
+			x, err := d.decode(values[i])
+			if err != nil {
+				return nil, err
+			}
+			m[gk] = x
+		}
+		return m, nil
+	default:
+		return nil, &UnsupportedObjectError{id, typeName(id)}
+	}
+}
+
+// enter marks the collection v as being converted and returns a function that
+// unmarks it. It returns UnsupportedObjectError if v is already being
+// converted, i.e. v contains itself.
+func (d *decoder) enter(v Object, id TypeID) (func(), error) {
+	p := v.Pointer()
+	if d.visiting[p] {
+		return nil, &UnsupportedObjectError{id, "encountered a cycle via " + typeName(id)}
+	}
+	d.visiting[p] = true
+	return func() { delete(d.visiting, p) }, nil
+}
+
+// typeName returns the name of the type for error messages.
+func typeName(id TypeID) string {
+	desc := CopyTypeIDDescription(id)
+	if desc == nil {
+		return fmt.Sprintf("type %d", id)
+	}
+	defer Release(desc)
+	return GoString(desc)
+}
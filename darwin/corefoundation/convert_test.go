@@ -0,0 +1,235 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+type convertBase struct {
+	ID   int64
+	Name string `cf:"base"`
+}
+
+type convertValue struct {
+	convertBase
+	Name     string                 `cf:"name"`
+	Tags     []string               `cf:"tags"`
+	Attrs    map[string]interface{} `cf:"attrs"`
+	Created  time.Time              `cf:"created"`
+	Raw      []byte                 `cf:"raw"`
+	Ratio    float32                `cf:"ratio"`
+	Count    uint8                  `cf:"count"`
+	Enabled  bool                   `cf:"enabled"`
+	Missing  *int                   `cf:"missing"`
+	Optional string                 `cf:"optional,omitempty"`
+	Ignored  string                 `cf:"-"`
+	Nested   map[string]string      `cf:"nested,omitempty"`
+	hidden   string
+}
+
+type convertEmbeddedCycle struct {
+	*convertEmbeddedCycle
+	Name string
+}
+
+func TestFromGoToGo(t *testing.T) {
+	created := time.Date(2022, 6, 1, 12, 34, 56, 0, time.UTC)
+	v := convertValue{
+		convertBase: convertBase{ID: 42, Name: "base"},
+		Name:        "example",
+		Tags:        []string{"a", "b"},
+		Attrs:       map[string]interface{}{"x": 1.5, "y": nil},
+		Created:     created,
+		Raw:         []byte{1, 2, 3},
+		Ratio:       0.5,
+		Count:       255,
+		Enabled:     true,
+		Ignored:     "ignored",
+		hidden:      "hidden",
+	}
+	obj, err := corefoundation.FromGo(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer corefoundation.Release(obj)
+
+	if _, ok := corefoundation.AsDictionary(obj); !ok {
+		t.Fatal("struct should convert to a dictionary")
+	}
+	got, err := corefoundation.ToGo(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := got.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected map[string]interface{}, got %T", got)
+	}
+	if c, ok := m["created"].(time.Time); !ok || !c.Equal(created) {
+		t.Errorf("expected created %v, got %v", created, m["created"])
+	}
+	delete(m, "created")
+	want := map[string]interface{}{
+		"ID":      int64(42),
+		"base":    "base",
+		"name":    "example",
+		"tags":    []interface{}{"a", "b"},
+		"attrs":   map[string]interface{}{"x": 1.5, "y": nil},
+		"raw":     []byte{1, 2, 3},
+		"ratio":   0.5,
+		"count":   int64(255),
+		"enabled": true,
+		"missing": nil,
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Errorf("expected %#v, got %#v", want, m)
+	}
+}
+
+func TestFromGoEmbeddedPointer(t *testing.T) {
+	type value struct {
+		*convertBase
+		Name string `cf:"name"`
+	}
+	testCases := []struct {
+		name string
+		v    value
+		want map[string]interface{}
+	}{
+		{
+			"Nil",
+			value{Name: "example"},
+			map[string]interface{}{"name": "example"},
+		},
+		{
+			"NonNil",
+			value{&convertBase{ID: 42, Name: "base"}, "example"},
+			map[string]interface{}{"ID": int64(42), "base": "base", "name": "example"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := corefoundation.FromGo(tc.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer corefoundation.Release(obj)
+			got, err := corefoundation.ToGo(obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFromGoObject(t *testing.T) {
+	s := corefoundation.NewString("x")
+	defer corefoundation.Release(s)
+
+	obj, err := corefoundation.FromGo([]corefoundation.Object{s, nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer corefoundation.Release(obj)
+	a, ok := corefoundation.AsArray(obj)
+	if !ok {
+		t.Fatal("slice should convert to an array")
+	}
+	if v := corefoundation.ArrayValueAt(a, 0); v.Pointer() != s.Pointer() {
+		t.Error("objects should convert to themselves")
+	}
+	if v := corefoundation.ArrayValueAt(a, 1); v.Pointer() != corefoundation.Null().Pointer() {
+		t.Error("nil object should convert to Null")
+	}
+}
+
+func TestFromGoErrors(t *testing.T) {
+	type cyclic struct {
+		Next *cyclic
+	}
+	c := &cyclic{}
+	c.Next = c
+
+	s := []interface{}{nil}
+	s[0] = s
+
+	e := &convertEmbeddedCycle{}
+	e.convertEmbeddedCycle = e
+
+	testCases := []struct {
+		name string
+		v    interface{}
+		err  interface{}
+	}{
+		{"Chan", make(chan int), new(*corefoundation.UnsupportedTypeError)},
+		{"Func", func() {}, new(*corefoundation.UnsupportedTypeError)},
+		{"Complex", complex(1, 2), new(*corefoundation.UnsupportedTypeError)},
+		{"MapKey", map[int]string{1: "a"}, new(*corefoundation.UnsupportedTypeError)},
+		{"Nested", []interface{}{1, map[string]interface{}{"f": func() {}}}, new(*corefoundation.UnsupportedTypeError)},
+		{"Overflow", uint64(math.MaxUint64), new(*corefoundation.UnsupportedValueError)},
+		{"CyclicPointer", c, new(*corefoundation.UnsupportedValueError)},
+		{"CyclicSlice", s, new(*corefoundation.UnsupportedValueError)},
+		{"CyclicEmbedded", e, new(*corefoundation.UnsupportedValueError)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			obj, err := corefoundation.FromGo(tc.v)
+			if err == nil {
+				corefoundation.Release(obj)
+				t.Fatal("expected an error")
+			}
+			if !errors.As(err, tc.err) {
+				t.Errorf("unexpected error type %T: %v", err, err)
+			}
+		})
+	}
+}
+
+func TestToGoErrors(t *testing.T) {
+	// The array does not retain its values, so it can contain itself.
+	m, ok := corefoundation.CreateMutableArray(
+		corefoundation.AllocatorDefault(),
+		0,
+		corefoundation.ArrayCallbacksNone(),
+	)
+	if !ok {
+		t.Fatal("failed to create a mutable array")
+	}
+	defer corefoundation.Release(m)
+	corefoundation.AppendToArray(m, m)
+
+	var e *corefoundation.UnsupportedObjectError
+	if _, err := corefoundation.ToGo(m); !errors.As(err, &e) {
+		t.Errorf("expected UnsupportedObjectError for cyclic array, got %v", err)
+	}
+	if _, err := corefoundation.ToGo(corefoundation.GetCurrentRunLoop()); !errors.As(err, &e) {
+		t.Errorf("expected UnsupportedObjectError for run loop, got %v", err)
+	}
+
+	// kCFStringEncodingUTF16LE allows creating a string with an unpaired
+	// surrogate.
+	const encodingUTF16LE corefoundation.StringEncoding = 0x14000100
+	k, ok := corefoundation.CreateStringWithBytes(corefoundation.AllocatorDefault(), []byte{0x00, 0xD8}, encodingUTF16LE, false)
+	if !ok {
+		t.Fatal("failed to create a string")
+	}
+	defer corefoundation.Release(k)
+	d, ok := corefoundation.CreateDictionary(corefoundation.AllocatorDefault(), []corefoundation.Object{k}, []corefoundation.Object{k})
+	if !ok {
+		t.Fatal("failed to create a dictionary")
+	}
+	defer corefoundation.Release(d)
+	if _, err := corefoundation.ToGo(d); !errors.As(err, &e) {
+		t.Errorf("expected UnsupportedObjectError for key with unpaired surrogate, got %v", err)
+	}
+}
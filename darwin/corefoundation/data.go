@@ -9,7 +9,10 @@ package corefoundation
 //  • https://developer.apple.com/documentation/corefoundation/cfdata-rv9
 
 import (
-	"github.com/noncgo/x/darwin/internal/cabi"
+	"errors"
+	"io"
+	"unsafe"
+
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -19,18 +22,61 @@ import (
 //  • https://developer.apple.com/documentation/corefoundation/cfdata
 type Data types.CFData
 
+// CreateData creates an immutable Data object with a copy of b.
+//
+// If there was a problem creating the object, it returns false. The caller
+// owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdatacreate(_:_:_:)
+func CreateData(alloc Allocator, b []byte) (Data, bool) {
+	out := cfDataCreate(alloc, b, len(b))
+	return out, out != 0
+}
+
+// CreateDataWithBytesNoCopy creates an immutable Data object that uses b as
+// its storage without copying. The Go memory is kept reachable until the
+// object no longer uses it, at which point free is called if it is not nil,
+// e.g. to return the buffer to a pool. The contents of b must not be modified
+// until then. If b is empty, the object does not use it and free is called
+// before CreateDataWithBytesNoCopy returns.
+//
+// The object is deallocated with a shared bytes deallocator that calls back
+// into Go, so it must be released on a thread created by Go. If the object is
+// released on another thread, e.g. by a dispatch queue, the memory is never
+// freed and free is not called.
+//
+// If there was a problem creating the object, it returns false and free is not
+// called. The caller owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdatacreatewithbytesnocopy(_:_:_:_:)
+func CreateDataWithBytesNoCopy(alloc Allocator, b []byte, free func()) (Data, bool) {
+	if len(b) == 0 {
+		out := cfDataCreate(alloc, nil, 0)
+		if out != 0 && free != nil {
+			free()
+		}
+		return out, out != 0
+	}
+	dealloc, ok := registerNoCopyBytes(b, free)
+	if !ok {
+		return nil, false
+	}
+	out := cfDataCreateWithBytesNoCopy(alloc, b, len(b), dealloc)
+	if out == 0 {
+		unregisterNoCopyBytes(b)
+		return nil, false
+	}
+	return out, true
+}
+
 // GetDataPointer returns a read-only pointer to the bytes of a Data object.
 //
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1543330-cfdatagetbyteptr
 func GetDataPointer(d Data) uintptr {
-	var out uintptr
-	cabi.Call(
-		extern_CFDataGetBytePtr_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(d.Pointer()),
-	)
-	return out
+	return cfDataGetBytePtr(d)
 }
 
 // GetDataLength returns the number of bytes contained by a Data object.
@@ -38,11 +84,130 @@ func GetDataPointer(d Data) uintptr {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1541728-cfdatagetlength
 func GetDataLength(d Data) int {
-	var out int
-	cabi.Call(
-		extern_CFDataGetLength_trampolineABI0,
-		cabi.OutInt(&out),
-		cabi.Uintptr(d.Pointer()),
-	)
-	return out
+	return cfDataGetLength(d)
+}
+
+// Bytes returns a copy of the bytes of a Data object.
+func Bytes(d Data) []byte {
+	n := GetDataLength(d)
+	if n == 0 {
+		return []byte{}
+	}
+	b := make([]byte, n)
+	cfDataGetBytes(d, 0, n, b)
+	return b
+}
+
+// View calls fn with the bytes of a Data object without copying them. The
+// object is retained until fn returns.
+//
+// The slice is only valid during the call and must not be modified or
+// retained by fn. The object must not be mutated concurrently.
+func View(d Data, fn func(b []byte)) {
+	r := Retain(d)
+	defer Release(r)
+	n := GetDataLength(d)
+	if n == 0 {
+		fn(nil)
+		return
+	}
+	p := GetDataPointer(d)
+	fn(unsafe.Slice(*(**byte)(unsafe.Pointer(&p)), n)[:n:n])
+}
+
+// errDataReaderClosed is returned by DataReader methods after Close.
+var errDataReaderClosed = errors.New("corefoundation: read from closed DataReader")
+
+// DataReader implements the io.Reader, io.ReaderAt, io.Seeker and io.Closer
+// interfaces by reading from a Data object. Unlike bytes.Reader, the bytes are
+// copied from the object as they are read, so large objects such as property
+// lists can be processed with standard APIs without copying them into Go
+// memory first.
+//
+// The zero value is not usable; use NewDataReader.
+type DataReader struct {
+	d   Data
+	off int64
+}
+
+// NewDataReader returns a DataReader that reads from d. The reader retains the
+// object until it is closed.
+func NewDataReader(d Data) *DataReader {
+	Retain(d)
+	return &DataReader{d: d}
+}
+
+// Size returns the length of the underlying Data object.
+func (r *DataReader) Size() int64 {
+	if r.d == nil {
+		return 0
+	}
+	return int64(GetDataLength(r.d))
+}
+
+// Read implements the io.Reader interface.
+func (r *DataReader) Read(b []byte) (int, error) {
+	n, err := r.ReadAt(b, r.off)
+	r.off += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+// ReadAt implements the io.ReaderAt interface.
+func (r *DataReader) ReadAt(b []byte, off int64) (int, error) {
+	if r.d == nil {
+		return 0, errDataReaderClosed
+	}
+	if off < 0 {
+		return 0, errors.New("corefoundation: DataReader.ReadAt: negative offset")
+	}
+	size := int64(GetDataLength(r.d))
+	if off >= size {
+		return 0, io.EOF
+	}
+	n := len(b)
+	if int64(n) > size-off {
+		n = int(size - off)
+	}
+	if n > 0 {
+		cfDataGetBytes(r.d, int(off), n, b[:n])
+	}
+	if n < len(b) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Seek implements the io.Seeker interface.
+func (r *DataReader) Seek(offset int64, whence int) (int64, error) {
+	if r.d == nil {
+		return 0, errDataReaderClosed
+	}
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.off
+	case io.SeekEnd:
+		offset += r.Size()
+	default:
+		return 0, errors.New("corefoundation: DataReader.Seek: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("corefoundation: DataReader.Seek: negative position")
+	}
+	r.off = offset
+	return offset, nil
+}
+
+// Close releases the underlying Data object. Subsequent reads return an
+// error.
+func (r *DataReader) Close() error {
+	if r.d == nil {
+		return errDataReaderClosed
+	}
+	Release(r.d)
+	r.d = nil
+	return nil
 }
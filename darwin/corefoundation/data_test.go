@@ -0,0 +1,161 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"testing/iotest"
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestCreateData(t *testing.T) {
+	b := []byte("example")
+	d, ok := corefoundation.CreateData(corefoundation.AllocatorDefault(), b)
+	if !ok {
+		t.Fatal("failed to create data")
+	}
+	defer corefoundation.Release(d)
+	b[0] = 'E'
+	if got := corefoundation.Bytes(d); string(got) != "example" {
+		t.Errorf("expected a copy of the bytes, got %q", got)
+	}
+	corefoundation.View(d, func(v []byte) {
+		if string(v) != "example" {
+			t.Errorf("unexpected view %q", v)
+		}
+		if cap(v) != len(v) {
+			t.Errorf("expected view capacity %d, got %d", len(v), cap(v))
+		}
+	})
+}
+
+func TestCreateDataWithBytesNoCopy(t *testing.T) {
+	b := []byte("example")
+	freed := 0
+	d, ok := corefoundation.CreateDataWithBytesNoCopy(corefoundation.AllocatorDefault(), b, func() {
+		freed++
+	})
+	if !ok {
+		t.Fatal("failed to create data")
+	}
+	if p := corefoundation.GetDataPointer(d); p != uintptr(unsafe.Pointer(&b[0])) {
+		t.Error("expected data to use the Go memory")
+	}
+	if got := corefoundation.Bytes(d); string(got) != "example" {
+		t.Errorf("unexpected bytes %q", got)
+	}
+	if freed != 0 {
+		t.Fatal("bytes freed before the data was released")
+	}
+	corefoundation.Release(d)
+	if freed != 1 {
+		t.Errorf("expected free to be called once, got %d", freed)
+	}
+
+	freed = 0
+	d, ok = corefoundation.CreateDataWithBytesNoCopy(corefoundation.AllocatorDefault(), nil, func() {
+		freed++
+	})
+	if !ok {
+		t.Fatal("failed to create empty data")
+	}
+	if freed != 1 {
+		t.Errorf("expected free to be called once for empty data, got %d", freed)
+	}
+	corefoundation.Release(d)
+}
+
+func TestCreateDataWithBytesNoCopyShared(t *testing.T) {
+	b := []byte("example")
+	var freed []int
+	var objects []corefoundation.Data
+	for i := 0; i < 2; i++ {
+		i := i
+		d, ok := corefoundation.CreateDataWithBytesNoCopy(corefoundation.AllocatorDefault(), b, func() {
+			freed = append(freed, i)
+		})
+		if !ok {
+			t.Fatal("failed to create data")
+		}
+		objects = append(objects, d)
+	}
+	corefoundation.Release(objects[1])
+	if len(freed) != 1 {
+		t.Fatalf("expected one free call after the first release, got %v", freed)
+	}
+	corefoundation.Release(objects[0])
+	if len(freed) != 2 || freed[0] == freed[1] {
+		t.Errorf("expected each free function to be called once, got %v", freed)
+	}
+}
+
+func TestMutableData(t *testing.T) {
+	alloc := corefoundation.AllocatorDefault()
+	m, ok := corefoundation.CreateMutableData(alloc, 0)
+	if !ok {
+		t.Fatal("failed to create mutable data")
+	}
+	defer corefoundation.Release(m)
+
+	corefoundation.AppendDataBytes(m, []byte("hello world"))
+	corefoundation.ReplaceDataBytes(m, corefoundation.Range{Location: 6, Length: 5}, []byte("data"))
+	corefoundation.DeleteDataBytes(m, corefoundation.Range{Location: 0, Length: 1})
+	if got := corefoundation.Bytes(m); string(got) != "ello data" {
+		t.Errorf("unexpected bytes %q", got)
+	}
+	corefoundation.SetDataLength(m, 11)
+	if got := corefoundation.Bytes(m); string(got) != "ello data\x00\x00" {
+		t.Errorf("unexpected bytes after extending %q", got)
+	}
+	corefoundation.SetDataLength(m, 4)
+
+	c, ok := corefoundation.CreateMutableDataCopy(alloc, 0, m)
+	if !ok {
+		t.Fatal("failed to copy mutable data")
+	}
+	defer corefoundation.Release(c)
+	corefoundation.AppendDataBytes(c, []byte("!"))
+	if got := corefoundation.Bytes(m); string(got) != "ello" {
+		t.Errorf("unexpected bytes of the original %q", got)
+	}
+	if got := corefoundation.Bytes(c); string(got) != "ello!" {
+		t.Errorf("unexpected bytes of the copy %q", got)
+	}
+}
+
+func TestDataReader(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	d, ok := corefoundation.CreateData(corefoundation.AllocatorDefault(), content)
+	if !ok {
+		t.Fatal("failed to create data")
+	}
+	r := corefoundation.NewDataReader(d)
+	// The reader holds its own reference.
+	corefoundation.Release(d)
+
+	if err := iotest.TestReader(r, content); err != nil {
+		t.Error(err)
+	}
+	if n := r.Size(); n != int64(len(content)) {
+		t.Errorf("expected size %d, got %d", len(content), n)
+	}
+	buf := make([]byte, 4)
+	if n, err := r.ReadAt(buf, int64(len(content))-2); n != 2 || err != io.EOF || string(buf[:n]) != "89" {
+		t.Errorf("unexpected ReadAt result %d, %v, %q", n, err, buf[:n])
+	}
+	if _, err := r.Seek(-1, io.SeekStart); err == nil {
+		t.Error("expected an error for negative position")
+	}
+
+	if err := r.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(buf); err == nil || err == io.EOF {
+		t.Errorf("expected an error after Close, got %v", err)
+	}
+}
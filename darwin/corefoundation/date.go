@@ -0,0 +1,41 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFDate APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdate
+
+import (
+	"time"
+
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// Date is an opaque reference to a CFDate type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdateref
+type Date types.CFDate
+
+// CreateDate creates a date for the given time. See AbsoluteTimeOf for
+// precision of the conversion.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdatecreate(_:_:)
+func CreateDate(alloc Allocator, t time.Time) (Date, bool) {
+	out := cfDateCreate(alloc, float64(AbsoluteTimeOf(t)))
+	return out, out != 0
+}
+
+// DateTime returns the time of a date in the local time zone.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdategetabsolutetime(_:)
+func DateTime(d Date) time.Time {
+	return AbsoluteTime(cfDateGetAbsoluteTime(d)).Time()
+}
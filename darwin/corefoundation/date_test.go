@@ -0,0 +1,27 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestCreateDate(t *testing.T) {
+	want := time.Date(2022, 6, 1, 12, 34, 56, 0, time.UTC)
+	d, ok := corefoundation.CreateDate(corefoundation.AllocatorDefault(), want)
+	if !ok {
+		t.Fatal("failed to create a date")
+	}
+	defer corefoundation.Release(d)
+
+	if _, ok := corefoundation.AsDate(d); !ok {
+		t.Error("not a date")
+	}
+	if got := corefoundation.DateTime(d); !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -3,12 +3,14 @@
 
 package corefoundation
 
-// This file provides CFDicionary APIs.
+// This file provides CFDictionary APIs.
 //
 // References
 //  • https://developer.apple.com/documentation/corefoundation/cfdictionary-rum
 
 import (
+	"unsafe"
+
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -17,3 +19,140 @@ import (
 // References
 //  • https://developer.apple.com/documentation/corefoundation/cfdictionary
 type Dictionary types.CFDictionary
+
+// CreateDictionary creates an immutable dictionary containing the given
+// key-value pairs. Both keys and values must be Core Foundation objects. The
+// dictionary retains them and compares keys using Equal and Hash.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarycreate(_:_:_:_:_:_:)
+//  • https://developer.apple.com/documentation/corefoundation/kcftypedictionarykeycallbacks
+//  • https://developer.apple.com/documentation/corefoundation/kcftypedictionaryvaluecallbacks
+func CreateDictionary(alloc Allocator, keys, values []Object) (Dictionary, bool) {
+	if len(keys) != len(values) {
+		panic("corefoundation: number of keys and values differ")
+	}
+	var keysPtr, valuesPtr unsafe.Pointer
+	if len(keys) > 0 {
+		keyAddrs := make([]uintptr, len(keys))
+		valueAddrs := make([]uintptr, len(values))
+		for i := range keys {
+			keyAddrs[i] = objectAddr(keys[i])
+			valueAddrs[i] = objectAddr(values[i])
+		}
+		keysPtr = unsafe.Pointer(&keyAddrs[0])
+		valuesPtr = unsafe.Pointer(&valueAddrs[0])
+	}
+
+	out := cfDictionaryCreate(
+		alloc,
+		keysPtr,
+		valuesPtr,
+		len(keys),
+		typeDictionaryKeyCallbacks(),
+		typeDictionaryValueCallbacks(),
+	)
+	return out, out != 0
+}
+
+// CreateDictionaryCopy creates an immutable dictionary with the same
+// key-value pairs as the given dictionary.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarycreatecopy(_:_:)
+func CreateDictionaryCopy(alloc Allocator, d Dictionary) (Dictionary, bool) {
+	out := cfDictionaryCreateCopy(alloc, d)
+	return out, out != 0
+}
+
+// DictionaryCount returns the number of key-value pairs in a dictionary.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarygetcount(_:)
+func DictionaryCount(d Dictionary) int {
+	return cfDictionaryGetCount(d)
+}
+
+// DictionaryValue returns the value associated with the given key. It returns
+// false if the key is not in the dictionary.
+//
+// The dictionary retains the ownership of the returned value.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarygetvalueifpresent(_:_:_:)
+func DictionaryValue(d Dictionary, key Object) (Object, bool) {
+	var v uintptr
+	if !cfDictionaryGetValueIfPresent(d, objectAddr(key), unsafe.Pointer(&v)) {
+		return nil, false
+	}
+	return types.Pointer(v), true
+}
+
+// DictionaryContainsKey reports whether the key is in the dictionary.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarycontainskey(_:_:)
+func DictionaryContainsKey(d Dictionary, key Object) bool {
+	return cfDictionaryContainsKey(d, objectAddr(key))
+}
+
+// DictionaryContainsValue reports whether the value is associated with any
+// key in the dictionary.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarycontainsvalue(_:_:)
+func DictionaryContainsValue(d Dictionary, v Object) bool {
+	return cfDictionaryContainsValue(d, objectAddr(v))
+}
+
+// DictionaryKeysAndValues returns keys and values of a dictionary. The i-th
+// value is associated with the i-th key. The order of pairs is undefined.
+//
+// The dictionary retains the ownership of the returned keys and values.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarygetkeysandvalues(_:_:_:)
+func DictionaryKeysAndValues(d Dictionary) (keys, values []Object) {
+	n := DictionaryCount(d)
+	if n == 0 {
+		return nil, nil
+	}
+	keyAddrs := make([]uintptr, n)
+	valueAddrs := make([]uintptr, n)
+	cfDictionaryGetKeysAndValues(d, unsafe.Pointer(&keyAddrs[0]), unsafe.Pointer(&valueAddrs[0]))
+
+	keys = make([]Object, n)
+	values = make([]Object, n)
+	for i := 0; i < n; i++ {
+		keys[i] = types.Pointer(keyAddrs[i])
+		values[i] = types.Pointer(valueAddrs[i])
+	}
+	return keys, values
+}
+
+// DictionaryToMap returns a map with the key-value pairs of a dictionary. It
+// returns false if any key is not a String or cannot be converted to UTF-8,
+// i.e. contains unpaired UTF-16 surrogates, since distinct keys would map to
+// the same Go string otherwise.
+//
+// The dictionary retains the ownership of the values in the map.
+func DictionaryToMap(d Dictionary) (map[string]Object, bool) {
+	keys, values := DictionaryKeysAndValues(d)
+	m := make(map[string]Object, len(keys))
+	for i, key := range keys {
+		s, ok := AsString(key)
+		if !ok {
+			return nil, false
+		}
+		k, ok := goString(s, false)
+		if !ok {
+			return nil, false
+		}
+		m[k] = values[i]
+	}
+	return m, true
+}
@@ -0,0 +1,24 @@
+//go:build darwin && go1.23
+// +build darwin,go1.23
+
+package corefoundation
+
+import (
+	"iter"
+)
+
+// Pairs returns an iterator over the key-value pairs in a dictionary. The
+// order of pairs is undefined.
+//
+// The pairs are read before the iteration starts, so the dictionary may be
+// modified during iteration.
+func Pairs(d Dictionary) iter.Seq2[Object, Object] {
+	return func(yield func(Object, Object) bool) {
+		keys, values := DictionaryKeysAndValues(d)
+		for i := range keys {
+			if !yield(keys[i], values[i]) {
+				return
+			}
+		}
+	}
+}
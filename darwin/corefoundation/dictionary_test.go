@@ -0,0 +1,166 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func createString(t *testing.T, s string) corefoundation.String {
+	t.Helper()
+	v, ok := corefoundation.CreateStringWithBytes(
+		corefoundation.AllocatorDefault(),
+		[]byte(s),
+		corefoundation.StringEncodingUTF8,
+		false,
+	)
+	if !ok {
+		t.Fatalf("failed to create string %q", s)
+	}
+	return v
+}
+
+func TestCreateDictionary(t *testing.T) {
+	alloc := corefoundation.AllocatorDefault()
+	a, b, c := createString(t, "a"), createString(t, "b"), createString(t, "c")
+	defer corefoundation.Release(a)
+	defer corefoundation.Release(b)
+	defer corefoundation.Release(c)
+
+	d, ok := corefoundation.CreateDictionary(
+		alloc,
+		[]corefoundation.Object{a, b},
+		[]corefoundation.Object{b, c},
+	)
+	if !ok {
+		t.Fatal("failed to create a dictionary")
+	}
+	defer corefoundation.Release(d)
+
+	if n := corefoundation.DictionaryCount(d); n != 2 {
+		t.Fatalf("expected 2 pairs, got %d", n)
+	}
+	// Keys are compared by value, not identity.
+	key := createString(t, "a")
+	defer corefoundation.Release(key)
+	v, ok := corefoundation.DictionaryValue(d, key)
+	if !ok || v.Pointer() != b.Pointer() {
+		t.Errorf("expected value %v for key a, got %v", b, v)
+	}
+	if !corefoundation.DictionaryContainsKey(d, key) {
+		t.Error("dictionary should contain key a")
+	}
+	if corefoundation.DictionaryContainsKey(d, c) {
+		t.Error("dictionary should not contain key c")
+	}
+	if _, ok := corefoundation.DictionaryValue(d, c); ok {
+		t.Error("expected no value for key c")
+	}
+	if !corefoundation.DictionaryContainsValue(d, c) {
+		t.Error("dictionary should contain value c")
+	}
+
+	m, ok := corefoundation.DictionaryToMap(d)
+	if !ok {
+		t.Fatal("failed to convert dictionary to map")
+	}
+	if len(m) != 2 || m["a"].Pointer() != b.Pointer() || m["b"].Pointer() != c.Pointer() {
+		t.Errorf("unexpected map %v", m)
+	}
+
+	cp, ok := corefoundation.CreateDictionaryCopy(alloc, d)
+	if !ok {
+		t.Fatal("failed to copy a dictionary")
+	}
+	defer corefoundation.Release(cp)
+	if !corefoundation.Equal(d, cp) {
+		t.Error("copy should be equal to the original dictionary")
+	}
+}
+
+func TestDictionaryToMapUnpairedSurrogates(t *testing.T) {
+	// kCFStringEncodingUTF16LE allows creating strings with unpaired
+	// surrogates that differ in UTF-16 but not after replacing them with
+	// U+FFFD in UTF-8.
+	const encodingUTF16LE corefoundation.StringEncoding = 0x14000100
+	var keys []corefoundation.Object
+	for _, b := range [][]byte{{0x00, 0xD8}, {0x01, 0xD8}} {
+		k, ok := corefoundation.CreateStringWithBytes(corefoundation.AllocatorDefault(), b, encodingUTF16LE, false)
+		if !ok {
+			t.Fatal("failed to create a string")
+		}
+		defer corefoundation.Release(k)
+		if gs := corefoundation.GoString(k); gs != "\uFFFD" {
+			t.Errorf("expected unpaired surrogate to be replaced, got %q", gs)
+		}
+		keys = append(keys, k)
+	}
+
+	d, ok := corefoundation.CreateDictionary(corefoundation.AllocatorDefault(), keys, keys)
+	if !ok {
+		t.Fatal("failed to create a dictionary")
+	}
+	defer corefoundation.Release(d)
+	if n := corefoundation.DictionaryCount(d); n != 2 {
+		t.Fatalf("expected 2 distinct keys, got %d", n)
+	}
+	if m, ok := corefoundation.DictionaryToMap(d); ok {
+		t.Errorf("expected conversion of colliding keys to fail, got %v", m)
+	}
+}
+
+func TestMutableDictionary(t *testing.T) {
+	alloc := corefoundation.AllocatorDefault()
+	a, b := createString(t, "a"), createString(t, "b")
+	defer corefoundation.Release(a)
+	defer corefoundation.Release(b)
+
+	m, ok := corefoundation.CreateMutableDictionary(alloc, 0)
+	if !ok {
+		t.Fatal("failed to create a mutable dictionary")
+	}
+	defer corefoundation.Release(m)
+
+	corefoundation.SetDictionaryValue(m, a, b)
+	corefoundation.AddDictionaryValue(m, a, a)
+	if v, ok := corefoundation.DictionaryValue(m, a); !ok || v.Pointer() != b.Pointer() {
+		t.Errorf("AddDictionaryValue should not replace an existing value")
+	}
+	corefoundation.ReplaceDictionaryValue(m, b, b)
+	if corefoundation.DictionaryContainsKey(m, b) {
+		t.Error("ReplaceDictionaryValue should not add a new key")
+	}
+	corefoundation.SetDictionaryValue(m, b, a)
+
+	keys, values := corefoundation.DictionaryKeysAndValues(m)
+	if len(keys) != 2 || len(values) != 2 {
+		t.Fatalf("expected 2 pairs, got %d keys and %d values", len(keys), len(values))
+	}
+	for i, key := range keys {
+		v, _ := corefoundation.DictionaryValue(m, key)
+		if v.Pointer() != values[i].Pointer() {
+			t.Errorf("pair %d: value does not match the key", i)
+		}
+	}
+
+	cp, ok := corefoundation.CreateMutableDictionaryCopy(alloc, 0, m)
+	if !ok {
+		t.Fatal("failed to copy a mutable dictionary")
+	}
+	defer corefoundation.Release(cp)
+
+	corefoundation.RemoveDictionaryValue(m, a)
+	if corefoundation.DictionaryContainsKey(m, a) {
+		t.Error("dictionary should not contain removed key")
+	}
+	corefoundation.RemoveAllDictionaryValues(m)
+	if n := corefoundation.DictionaryCount(m); n != 0 {
+		t.Errorf("expected empty dictionary, got %d pairs", n)
+	}
+	if n := corefoundation.DictionaryCount(cp); n != 2 {
+		t.Errorf("copy should not be modified, got %d pairs", n)
+	}
+}
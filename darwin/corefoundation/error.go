@@ -0,0 +1,238 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFError APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cferror-ru8
+
+import (
+	"errors"
+	"strconv"
+	"syscall"
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// ErrorObject is an opaque reference to a CFError type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cferror
+type ErrorObject types.CFError
+
+// Error domains that identify the origin of error codes.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cferrordomain
+const (
+	// ErrorDomainPOSIX is the domain of POSIX errno values.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/kcferrordomainposix
+	ErrorDomainPOSIX = "NSPOSIXErrorDomain"
+
+	// ErrorDomainOSStatus is the domain of OSStatus codes of Carbon and
+	// other APIs.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/kcferrordomainosstatus
+	ErrorDomainOSStatus = "NSOSStatusErrorDomain"
+
+	// ErrorDomainMach is the domain of Mach kern_return_t codes.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/kcferrordomainmach
+	ErrorDomainMach = "NSMachErrorDomain"
+
+	// ErrorDomainCocoa is the domain of Foundation and Core Foundation
+	// errors, e.g. property list errors.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/kcferrordomaincocoa
+	ErrorDomainCocoa = "NSCocoaErrorDomain"
+)
+
+// Error is a Go representation of a CFError object.
+//
+// It supports errors.Is with syscall.Errno targets for errors in the POSIX
+// domain and for OSStatus codes with a POSIX equivalent, e.g.
+//
+//	errors.Is(err, syscall.ENOENT)
+//
+// reports whether err is a POSIX ENOENT error or an OSStatus fnfErr error.
+// Errors are also equal to *Error targets with the same domain and code.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cferror
+type Error struct {
+	// Domain is the error domain, e.g. ErrorDomainPOSIX.
+	Domain string
+	// Code is the error code within the domain.
+	Code int
+	// Description is the localized description of the error.
+	Description string
+	// FailureReason is the localized explanation of the reason for the
+	// error, if any.
+	FailureReason string
+	// RecoverySuggestion is the localized recovery suggestion, if any.
+	RecoverySuggestion string
+	// UnderlyingError is the error that caused the error, if any.
+	UnderlyingError error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Description != "" {
+		return "corefoundation: " + e.Description
+	}
+	return "corefoundation: " + e.Domain + " error " + strconv.Itoa(e.Code)
+}
+
+// Unwrap returns the underlying error.
+func (e *Error) Unwrap() error {
+	return e.UnderlyingError
+}
+
+// Is reports whether the error matches target, i.e. target is an *Error with
+// the same domain and code, or the error has an equivalent errno value that
+// matches target as syscall.Errno does, e.g. fs.ErrNotExist for ENOENT.
+func (e *Error) Is(target error) bool {
+	if t, ok := target.(*Error); ok {
+		return t.Domain == e.Domain && t.Code == e.Code
+	}
+	errno, ok := e.errno()
+	return ok && (target == errno || errno.Is(target))
+}
+
+// posixErrorBase is the OSStatus code that corresponds to zero errno value.
+//
+// References
+//  • https://opensource.apple.com/source/CarbonHeaders/CarbonHeaders-18.1/MacErrors.h
+const posixErrorBase = 100000
+
+// osStatusErrno maps OSStatus codes to equivalent errno values.
+//
+// References
+//  • https://opensource.apple.com/source/CarbonHeaders/CarbonHeaders-18.1/MacErrors.h
+var osStatusErrno = map[int]syscall.Errno{
+	-34:   syscall.ENOSPC,    // dskFulErr
+	-36:   syscall.EIO,       // ioErr
+	-42:   syscall.EMFILE,    // tmfoErr
+	-43:   syscall.ENOENT,    // fnfErr
+	-44:   syscall.EROFS,     // wPrErr
+	-46:   syscall.EROFS,     // vLckdErr
+	-47:   syscall.EBUSY,     // fBsyErr
+	-48:   syscall.EEXIST,    // dupFNErr
+	-50:   syscall.EINVAL,    // paramErr
+	-54:   syscall.EACCES,    // permErr
+	-108:  syscall.ENOMEM,    // memFullErr
+	-120:  syscall.ENOENT,    // dirNFErr
+	-128:  syscall.ECANCELED, // userCanceledErr
+	-5000: syscall.EACCES,    // afpAccessDenied
+}
+
+// errno returns the errno value that is equivalent to the error.
+func (e *Error) errno() (syscall.Errno, bool) {
+	switch e.Domain {
+	case ErrorDomainPOSIX:
+		if e.Code > 0 {
+			return syscall.Errno(e.Code), true
+		}
+	case ErrorDomainOSStatus:
+		if e.Code > posixErrorBase {
+			return syscall.Errno(e.Code - posixErrorBase), true
+		}
+		errno, ok := osStatusErrno[e.Code]
+		return errno, ok
+	}
+	return 0, false
+}
+
+// GoError returns an *Error for the given CFError object, or nil if e is nil.
+// The underlying errors are converted recursively.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cferrorgetdomain(_:)
+//  • https://developer.apple.com/documentation/corefoundation/cferrorgetcode(_:)
+//  • https://developer.apple.com/documentation/corefoundation/cferrorcopydescription(_:)
+//  • https://developer.apple.com/documentation/corefoundation/cferrorcopyfailurereason(_:)
+//  • https://developer.apple.com/documentation/corefoundation/cferrorcopyrecoverysuggestion(_:)
+//  • https://developer.apple.com/documentation/corefoundation/cferrorcopyuserinfo(_:)
+func GoError(e ErrorObject) error {
+	if e == nil || e.Pointer() == 0 {
+		return nil
+	}
+	err := &Error{
+		Domain:             GoString(String(cfErrorGetDomain(e))),
+		Code:               cfErrorGetCode(e),
+		Description:        copyErrorString(cfErrorCopyDescription(e)),
+		FailureReason:      copyErrorString(cfErrorCopyFailureReason(e)),
+		RecoverySuggestion: copyErrorString(cfErrorCopyRecoverySuggestion(e)),
+	}
+	if userInfo := cfErrorCopyUserInfo(e); userInfo != 0 {
+		defer Release(userInfo)
+		if v, ok := DictionaryValue(userInfo, ErrorUnderlyingErrorKey()); ok {
+			if u, ok := AsErrorObject(v); ok {
+				err.UnderlyingError = GoError(u)
+			}
+		}
+	}
+	return err
+}
+
+// copyErrorString returns the contents of an owned String, that may be NULL,
+// and releases it.
+func copyErrorString(s types.Pointer) string {
+	if s == 0 {
+		return ""
+	}
+	defer Release(s)
+	return GoString(s)
+}
+
+// CreateError creates an ErrorObject with the given domain, code and an
+// optional dictionary of additional information, e.g. the localized
+// description for ErrorLocalizedDescriptionKey and the underlying error for
+// ErrorUnderlyingErrorKey. The userInfo may be nil.
+//
+// If there was a problem creating the object, it returns false. The caller
+// owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cferrorcreate(_:_:_:_:)
+func CreateError(alloc Allocator, domain string, code int, userInfo Dictionary) (ErrorObject, bool) {
+	d := NewString(domain)
+	if d == nil {
+		return nil, false
+	}
+	defer Release(d)
+	if userInfo == nil {
+		userInfo = types.Pointer(0)
+	}
+	out := cfErrorCreate(alloc, d, code, userInfo)
+	return out, out != 0
+}
+
+// errorOut is a CFErrorRef out-parameter.
+type errorOut uintptr
+
+// ptr returns a pointer to pass as CFErrorRef *error argument.
+func (e *errorOut) ptr() unsafe.Pointer {
+	return unsafe.Pointer(e)
+}
+
+// take returns an *Error for the stored CFError and releases it. If there is
+// no error, i.e. a function failed without reporting one, it returns an error
+// for the given operation.
+func (e *errorOut) take(op string) error {
+	if *e == 0 {
+		return errors.New("corefoundation: " + op + " failed")
+	}
+	cf := ErrorObject(types.Pointer(*e))
+	*e = 0
+	defer Release(cf)
+	return GoError(cf)
+}
@@ -0,0 +1,129 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"errors"
+	"io/fs"
+	"syscall"
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestGoError(t *testing.T) {
+	alloc := corefoundation.AllocatorDefault()
+	underlying, ok := corefoundation.CreateError(alloc, corefoundation.ErrorDomainOSStatus, -54, nil)
+	if !ok {
+		t.Fatal("failed to create underlying error")
+	}
+	defer corefoundation.Release(underlying)
+	desc := corefoundation.NewString("custom description")
+	defer corefoundation.Release(desc)
+	userInfo, ok := corefoundation.CreateDictionary(alloc,
+		[]corefoundation.Object{corefoundation.ErrorLocalizedDescriptionKey(), corefoundation.ErrorUnderlyingErrorKey()},
+		[]corefoundation.Object{desc, underlying},
+	)
+	if !ok {
+		t.Fatal("failed to create userInfo")
+	}
+	defer corefoundation.Release(userInfo)
+	e, ok := corefoundation.CreateError(alloc, corefoundation.ErrorDomainPOSIX, int(syscall.ENOENT), userInfo)
+	if !ok {
+		t.Fatal("failed to create error")
+	}
+	defer corefoundation.Release(e)
+
+	err := corefoundation.GoError(e)
+	var cfErr *corefoundation.Error
+	if !errors.As(err, &cfErr) {
+		t.Fatalf("expected *Error, got %T", err)
+	}
+	if cfErr.Domain != corefoundation.ErrorDomainPOSIX || cfErr.Code != int(syscall.ENOENT) {
+		t.Errorf("unexpected domain %q and code %d", cfErr.Domain, cfErr.Code)
+	}
+	if cfErr.Description != "custom description" {
+		t.Errorf("unexpected description %q", cfErr.Description)
+	}
+	if s := err.Error(); s != "corefoundation: custom description" {
+		t.Errorf("unexpected error message %q", s)
+	}
+	u, ok := cfErr.UnderlyingError.(*corefoundation.Error)
+	if !ok || u.Domain != corefoundation.ErrorDomainOSStatus || u.Code != -54 {
+		t.Errorf("unexpected underlying error %#v", cfErr.UnderlyingError)
+	}
+	if !errors.Is(err, syscall.ENOENT) {
+		t.Error("expected ENOENT error")
+	}
+	if !errors.Is(err, syscall.EACCES) {
+		t.Error("expected EACCES error for the underlying permErr")
+	}
+	if errors.Is(err, syscall.EEXIST) {
+		t.Error("unexpected EEXIST error")
+	}
+	if !errors.Is(err, &corefoundation.Error{Domain: corefoundation.ErrorDomainOSStatus, Code: -54}) {
+		t.Error("expected permErr error")
+	}
+
+	if err := corefoundation.GoError(nil); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	testCases := []struct {
+		domain string
+		code   int
+		errno  syscall.Errno
+	}{
+		{corefoundation.ErrorDomainPOSIX, int(syscall.EPERM), syscall.EPERM},
+		{corefoundation.ErrorDomainOSStatus, -43, syscall.ENOENT},
+		{corefoundation.ErrorDomainOSStatus, 100000 + int(syscall.EINTR), syscall.EINTR},
+		{corefoundation.ErrorDomainOSStatus, -1, 0},
+		{corefoundation.ErrorDomainPOSIX, 0, 0},
+		{corefoundation.ErrorDomainCocoa, int(syscall.ENOENT), 0},
+	}
+	errnos := []syscall.Errno{syscall.EPERM, syscall.ENOENT, syscall.EINTR}
+	for _, tc := range testCases {
+		err := &corefoundation.Error{Domain: tc.domain, Code: tc.code}
+		for _, errno := range errnos {
+			if got := errors.Is(err, errno); got != (errno == tc.errno) {
+				t.Errorf("errors.Is(%v, %v) = %v", err, errno, got)
+			}
+		}
+		if got, want := errors.Is(err, fs.ErrNotExist), tc.errno == syscall.ENOENT; got != want {
+			t.Errorf("errors.Is(%v, fs.ErrNotExist) = %v", err, got)
+		}
+		if got, want := errors.Is(err, fs.ErrPermission), tc.errno == syscall.EPERM; got != want {
+			t.Errorf("errors.Is(%v, fs.ErrPermission) = %v", err, got)
+		}
+	}
+}
+
+func TestPropertyListError(t *testing.T) {
+	_, err := corefoundation.Unmarshal([]byte("<plist><dict><key>x</key></plist>"))
+	var cfErr *corefoundation.Error
+	if !errors.As(err, &cfErr) {
+		t.Fatalf("expected *Error, got %v", err)
+	}
+	// NSPropertyListReadCorruptError.
+	if cfErr.Domain != corefoundation.ErrorDomainCocoa || cfErr.Code != 3840 {
+		t.Errorf("unexpected domain %q and code %d", cfErr.Domain, cfErr.Code)
+	}
+}
+
+func TestStreamError(t *testing.T) {
+	r, ok := corefoundation.CreateReadStreamWithBytes(corefoundation.AllocatorDefault(), []byte("x"))
+	if !ok {
+		t.Fatal("failed to create stream")
+	}
+	defer corefoundation.Release(r)
+	if !corefoundation.OpenReadStream(r) {
+		t.Fatal("failed to open stream")
+	}
+	defer corefoundation.CloseReadStream(r)
+	if err := corefoundation.ReadStreamError(r); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
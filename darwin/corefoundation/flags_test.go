@@ -0,0 +1,56 @@
+package corefoundation_test
+
+import (
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestRunLoopActivityString(t *testing.T) {
+	testCases := []struct {
+		activity corefoundation.RunLoopActivity
+		str      string
+	}{
+		{corefoundation.RunLoopActivityEntry, "Entry"},
+		{corefoundation.RunLoopActivityBeforeWaiting | corefoundation.RunLoopActivityAfterWaiting, "BeforeWaiting|AfterWaiting"},
+		{corefoundation.RunLoopActivityAll, "All"},
+		{corefoundation.RunLoopActivityExit | 1<<8, "Exit|100000000"},
+	}
+	for _, tc := range testCases {
+		if got := tc.activity.String(); got != tc.str {
+			t.Errorf("RunLoopActivity(%#x).String() = %q, want %q", uint(tc.activity), got, tc.str)
+		}
+		v, err := corefoundation.ParseRunLoopActivity(tc.str)
+		if err != nil {
+			t.Errorf("ParseRunLoopActivity(%q): %v", tc.str, err)
+			continue
+		}
+		if v != tc.activity {
+			t.Errorf("ParseRunLoopActivity(%q) = %#x, want %#x", tc.str, uint(v), uint(tc.activity))
+		}
+	}
+}
+
+func TestStringCompareFlagsString(t *testing.T) {
+	testCases := []struct {
+		flags corefoundation.StringCompareFlags
+		str   string
+	}{
+		{corefoundation.CompareCaseInsensitive, "CaseInsensitive"},
+		{corefoundation.CompareBackwards | corefoundation.CompareAnchored, "Backwards|Anchored"},
+		{corefoundation.CompareForcedOrdering | 1<<1, "ForcedOrdering|10"},
+	}
+	for _, tc := range testCases {
+		if got := tc.flags.String(); got != tc.str {
+			t.Errorf("StringCompareFlags(%#x).String() = %q, want %q", uint(tc.flags), got, tc.str)
+		}
+		v, err := corefoundation.ParseStringCompareFlags(tc.str)
+		if err != nil {
+			t.Errorf("ParseStringCompareFlags(%q): %v", tc.str, err)
+			continue
+		}
+		if v != tc.flags {
+			t.Errorf("ParseStringCompareFlags(%q) = %#x, want %#x", tc.str, uint(v), uint(tc.flags))
+		}
+	}
+}
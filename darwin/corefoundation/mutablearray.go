@@ -9,7 +9,6 @@ package corefoundation
 //  • https://developer.apple.com/documentation/corefoundation/cfmutablearray-rrk
 
 import (
-	"github.com/noncgo/x/darwin/internal/cabi"
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -26,20 +25,35 @@ type MutableArray types.CFMutableArray
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1388770-cfarraycreatemutable
 func CreateMutableArray(alloc Allocator, capacity int, callbacks *ArrayCallbacks) (MutableArray, bool) {
-	var callbacksAddr uintptr
-	if callbacks == ArrayCallbacksForObject() {
-		callbacksAddr = extern_kCFTypeArrayCallBacks_getAddr()
+	alloc, callbacksPtr, release, ok := callbacks.acquire(alloc)
+	if !ok {
+		return nil, false
 	}
+	defer release()
 
-	var out uintptr
-	cabi.Call(
-		extern_CFArrayCreateMutable_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(alloc.Pointer()),
-		cabi.Int(capacity),
-		cabi.Uintptr(callbacksAddr),
-	)
-	return types.Pointer(out), out != 0
+	out := cfArrayCreateMutable(alloc, capacity, callbacksPtr)
+	return out, out != 0
+}
+
+// CreateMutableArrayCopy creates a new mutable array with the same values and
+// callbacks as the given array.
+//
+// If capacity is not zero, it is the maximum number of values the array can
+// contain and must not be less than the number of values in a.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfarraycreatemutablecopy(_:_:_:)
+func CreateMutableArrayCopy(alloc Allocator, capacity int, a Array) (MutableArray, bool) {
+	alloc, _, release, ok := arrayCallbacksOf(a).acquire(alloc)
+	if !ok {
+		return nil, false
+	}
+	defer release()
+
+	out := cfArrayCreateMutableCopy(alloc, capacity, a)
+	return out, out != 0
 }
 
 // AppendToArray adds a value to an array giving it the new largest index.
@@ -51,15 +65,5 @@ func CreateMutableArray(alloc Allocator, capacity int, callbacks *ArrayCallbacks
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1388802-cfarrayappendvalue
 func AppendToArray(m MutableArray, v Object) {
-	var objectAddr uintptr
-	if v != nil {
-		objectAddr = v.Pointer()
-	}
-
-	cabi.Call(
-		extern_CFArrayAppendValue_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(m.Pointer()),
-		cabi.Uintptr(objectAddr),
-	)
+	cfArrayAppendValue(m, objectAddr(v))
 }
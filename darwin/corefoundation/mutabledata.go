@@ -0,0 +1,84 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFMutableData APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfmutabledata-rvc
+
+import (
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// MutableData is an opaque reference to CFMutableData type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfmutabledataref
+type MutableData types.CFMutableData
+
+// CreateMutableData creates an empty mutable Data object. A capacity of zero
+// means that the object may grow without limit, otherwise it must not grow
+// beyond the given number of bytes.
+//
+// If there was a problem creating the object, it returns false. The caller
+// owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdatacreatemutable(_:_:)
+func CreateMutableData(alloc Allocator, capacity int) (MutableData, bool) {
+	out := cfDataCreateMutable(alloc, capacity)
+	return out, out != 0
+}
+
+// CreateMutableDataCopy creates a mutable Data object with a copy of the bytes
+// of d. The capacity is interpreted as in CreateMutableData and must be at
+// least the length of d if not zero.
+//
+// If there was a problem creating the object, it returns false. The caller
+// owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdatacreatemutablecopy(_:_:_:)
+func CreateMutableDataCopy(alloc Allocator, capacity int, d Data) (MutableData, bool) {
+	out := cfDataCreateMutableCopy(alloc, capacity, d)
+	return out, out != 0
+}
+
+// AppendDataBytes appends a copy of b to the bytes of a mutable Data object.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdataappendbytes(_:_:_:)
+func AppendDataBytes(m MutableData, b []byte) {
+	if len(b) == 0 {
+		return
+	}
+	cfDataAppendBytes(m, b, len(b))
+}
+
+// ReplaceDataBytes replaces the bytes in the range r of a mutable Data object
+// with a copy of b, growing or shrinking the object as necessary.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdatareplacebytes(_:_:_:_:)
+func ReplaceDataBytes(m MutableData, r Range, b []byte) {
+	cfDataReplaceBytes(m, r.Location, r.Length, b, len(b))
+}
+
+// DeleteDataBytes deletes the bytes in the range r of a mutable Data object.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdatadeletebytes(_:_:)
+func DeleteDataBytes(m MutableData, r Range) {
+	cfDataDeleteBytes(m, r.Location, r.Length)
+}
+
+// SetDataLength sets the length of a mutable Data object, truncating it or
+// extending it with zero bytes.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdatasetlength(_:_:)
+func SetDataLength(m MutableData, n int) {
+	cfDataSetLength(m, n)
+}
@@ -0,0 +1,98 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFMutableDictionary APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfmutabledictionary-s6g
+
+import (
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// MutableDictionary is an opaque reference to CFMutableDictionary type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfmutabledictionaryref
+type MutableDictionary types.CFMutableDictionary
+
+// CreateMutableDictionary creates a new mutable dictionary. Both keys and
+// values must be Core Foundation objects. The dictionary retains them and
+// compares keys using Equal and Hash.
+//
+// If capacity is not zero, it is the maximum number of key-value pairs the
+// dictionary can contain.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarycreatemutable(_:_:_:_:)
+func CreateMutableDictionary(alloc Allocator, capacity int) (MutableDictionary, bool) {
+	out := cfDictionaryCreateMutable(
+		alloc,
+		capacity,
+		typeDictionaryKeyCallbacks(),
+		typeDictionaryValueCallbacks(),
+	)
+	return out, out != 0
+}
+
+// CreateMutableDictionaryCopy creates a new mutable dictionary with the
+// key-value pairs of the given dictionary.
+//
+// If capacity is not zero, it is the maximum number of key-value pairs the
+// dictionary can contain and must not be less than the number of pairs in d.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarycreatemutablecopy(_:_:_:)
+func CreateMutableDictionaryCopy(alloc Allocator, capacity int, d Dictionary) (MutableDictionary, bool) {
+	out := cfDictionaryCreateMutableCopy(alloc, capacity, d)
+	return out, out != 0
+}
+
+// SetDictionaryValue sets the value for the key in a dictionary, replacing
+// the previous value if the key already exists.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionarysetvalue(_:_:_:)
+func SetDictionaryValue(m MutableDictionary, key, v Object) {
+	cfDictionarySetValue(m, objectAddr(key), objectAddr(v))
+}
+
+// AddDictionaryValue adds the key-value pair to a dictionary if the key does
+// not already exist.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionaryaddvalue(_:_:_:)
+func AddDictionaryValue(m MutableDictionary, key, v Object) {
+	cfDictionaryAddValue(m, objectAddr(key), objectAddr(v))
+}
+
+// ReplaceDictionaryValue replaces the value for the key in a dictionary if
+// the key exists.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionaryreplacevalue(_:_:_:)
+func ReplaceDictionaryValue(m MutableDictionary, key, v Object) {
+	cfDictionaryReplaceValue(m, objectAddr(key), objectAddr(v))
+}
+
+// RemoveDictionaryValue removes the key and its value from a dictionary.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionaryremovevalue(_:_:)
+func RemoveDictionaryValue(m MutableDictionary, key Object) {
+	cfDictionaryRemoveValue(m, objectAddr(key))
+}
+
+// RemoveAllDictionaryValues removes all key-value pairs from a dictionary.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfdictionaryremoveallvalues(_:)
+func RemoveAllDictionaryValues(m MutableDictionary) {
+	cfDictionaryRemoveAllValues(m)
+}
@@ -0,0 +1,20 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFNull APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnull
+
+import (
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// NullObject is an opaque reference to a CFNull type. The only value of the
+// type is returned by Null.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnullref
+type NullObject types.CFNull
@@ -0,0 +1,267 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFNumber APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumber-rlw
+
+import (
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// Number is an opaque reference to a CFNumber type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumberref
+type Number types.CFNumber
+
+// NumberType specifies the C type of a number value.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype
+type NumberType int
+
+const (
+	// NumberSInt8Type is a signed 8-bit integer, i.e. int8_t.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/sint8type
+	NumberSInt8Type NumberType = 1
+
+	// NumberSInt16Type is a signed 16-bit integer, i.e. int16_t.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/sint16type
+	NumberSInt16Type NumberType = 2
+
+	// NumberSInt32Type is a signed 32-bit integer, i.e. int32_t.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/sint32type
+	NumberSInt32Type NumberType = 3
+
+	// NumberSInt64Type is a signed 64-bit integer, i.e. int64_t.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/sint64type
+	NumberSInt64Type NumberType = 4
+
+	// NumberFloat32Type is a 32-bit IEEE 754 floating point number.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/float32type
+	NumberFloat32Type NumberType = 5
+
+	// NumberFloat64Type is a 64-bit IEEE 754 floating point number.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/float64type
+	NumberFloat64Type NumberType = 6
+
+	// NumberCharType is the C char type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/chartype
+	NumberCharType NumberType = 7
+
+	// NumberShortType is the C short type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/shorttype
+	NumberShortType NumberType = 8
+
+	// NumberIntType is the C int type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/inttype
+	NumberIntType NumberType = 9
+
+	// NumberLongType is the C long type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/longtype
+	NumberLongType NumberType = 10
+
+	// NumberLongLongType is the C long long type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/longlongtype
+	NumberLongLongType NumberType = 11
+
+	// NumberFloatType is the C float type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/floattype
+	NumberFloatType NumberType = 12
+
+	// NumberDoubleType is the C double type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/doubletype
+	NumberDoubleType NumberType = 13
+
+	// NumberCFIndexType is the CFIndex type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/cfindextype
+	NumberCFIndexType NumberType = 14
+
+	// NumberNSIntegerType is the NSInteger type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/nsintegertype
+	NumberNSIntegerType NumberType = 15
+
+	// NumberCGFloatType is the CGFloat type.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfnumbertype/cgfloattype
+	NumberCGFloatType NumberType = 16
+)
+
+// size returns the size of the C type in bytes or zero if the type is not
+// known.
+func (t NumberType) size() int {
+	switch t {
+	case NumberSInt8Type, NumberCharType:
+		return 1
+	case NumberSInt16Type, NumberShortType:
+		return 2
+	case NumberSInt32Type, NumberIntType, NumberFloat32Type, NumberFloatType:
+		return 4
+	case NumberSInt64Type, NumberLongType, NumberLongLongType,
+		NumberFloat64Type, NumberDoubleType, NumberCGFloatType,
+		NumberCFIndexType, NumberNSIntegerType:
+		return 8
+	}
+	return 0
+}
+
+// isFloat reports whether the C type is a floating point type.
+func (t NumberType) isFloat() bool {
+	switch t {
+	case NumberFloat32Type, NumberFloatType,
+		NumberFloat64Type, NumberDoubleType, NumberCGFloatType:
+		return true
+	}
+	return false
+}
+
+// numberValue is a buffer for a value of any NumberType.
+type numberValue struct {
+	v uint64
+}
+
+// setInt stores v converted to the C type t.
+func (b *numberValue) setInt(t NumberType, v int64) {
+	p := unsafe.Pointer(&b.v)
+	switch {
+	case t.isFloat():
+		b.setFloat(t, float64(v))
+	case t.size() == 1:
+		*(*int8)(p) = int8(v)
+	case t.size() == 2:
+		*(*int16)(p) = int16(v)
+	case t.size() == 4:
+		*(*int32)(p) = int32(v)
+	default:
+		*(*int64)(p) = v
+	}
+}
+
+// setFloat stores v converted to the C type t.
+func (b *numberValue) setFloat(t NumberType, v float64) {
+	p := unsafe.Pointer(&b.v)
+	switch {
+	case !t.isFloat():
+		b.setInt(t, int64(v))
+	case t.size() == 4:
+		*(*float32)(p) = float32(v)
+	default:
+		*(*float64)(p) = v
+	}
+}
+
+// CreateNumber creates a number of the given type with the integer value v.
+// The value is converted to the C type t using Go conversion rules, i.e.
+// integers are truncated.
+//
+// If t is not a valid NumberType or there was a problem creating the object,
+// it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumbercreate(_:_:_:)
+func CreateNumber(alloc Allocator, t NumberType, v int64) (Number, bool) {
+	if t.size() == 0 {
+		return nil, false
+	}
+	var b numberValue
+	b.setInt(t, v)
+	out := cfNumberCreate(alloc, int(t), unsafe.Pointer(&b.v))
+	return out, out != 0
+}
+
+// CreateFloatNumber creates a number of the given type with the floating point
+// value v. The value is converted to the C type t using Go conversion rules.
+//
+// If t is not a valid NumberType or there was a problem creating the object,
+// it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumbercreate(_:_:_:)
+func CreateFloatNumber(alloc Allocator, t NumberType, v float64) (Number, bool) {
+	if t.size() == 0 {
+		return nil, false
+	}
+	var b numberValue
+	b.setFloat(t, v)
+	out := cfNumberCreate(alloc, int(t), unsafe.Pointer(&b.v))
+	return out, out != 0
+}
+
+// GetNumberType returns the type used to create the number. Note that the
+// type may differ from the one passed to CreateNumber, e.g. for unsigned
+// values and types that are aliases on the current platform.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumbergettype(_:)
+func GetNumberType(n Number) NumberType {
+	return NumberType(cfNumberGetType(n))
+}
+
+// NumberIsFloat reports whether the number has a floating point type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumberisfloattype(_:)
+func NumberIsFloat(n Number) bool {
+	return cfNumberIsFloatType(n)
+}
+
+// NumberInt64Value returns the value of the number as int64. It returns false
+// if the conversion is lossy, e.g. the value has a fractional part or is out
+// of range. The returned value is valid in either case.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumbergetvalue(_:_:_:)
+func NumberInt64Value(n Number) (int64, bool) {
+	var v int64
+	exact := cfNumberGetValue(n, int(NumberSInt64Type), unsafe.Pointer(&v))
+	return v, exact
+}
+
+// NumberFloat64Value returns the value of the number as float64. It returns
+// false if the conversion is lossy, e.g. an integer value does not fit in
+// float64 mantissa. The returned value is valid in either case.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfnumbergetvalue(_:_:_:)
+func NumberFloat64Value(n Number) (float64, bool) {
+	var v float64
+	exact := cfNumberGetValue(n, int(NumberFloat64Type), unsafe.Pointer(&v))
+	return v, exact
+}
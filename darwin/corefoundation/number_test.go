@@ -0,0 +1,91 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestCreateNumber(t *testing.T) {
+	alloc := corefoundation.AllocatorDefault()
+	testCases := []struct {
+		typ   corefoundation.NumberType
+		v     int64
+		want  int64
+		float bool
+	}{
+		{corefoundation.NumberSInt8Type, -5, -5, false},
+		{corefoundation.NumberSInt8Type, 300, 44, false},
+		{corefoundation.NumberSInt16Type, -30000, -30000, false},
+		{corefoundation.NumberSInt32Type, math.MaxInt32, math.MaxInt32, false},
+		{corefoundation.NumberSInt64Type, math.MinInt64, math.MinInt64, false},
+		{corefoundation.NumberCharType, 'a', 'a', false},
+		{corefoundation.NumberShortType, 7, 7, false},
+		{corefoundation.NumberIntType, 8, 8, false},
+		{corefoundation.NumberLongType, 9, 9, false},
+		{corefoundation.NumberLongLongType, 10, 10, false},
+		{corefoundation.NumberCFIndexType, 11, 11, false},
+		{corefoundation.NumberNSIntegerType, 12, 12, false},
+		{corefoundation.NumberFloat32Type, 13, 13, true},
+		{corefoundation.NumberFloat64Type, 14, 14, true},
+		{corefoundation.NumberFloatType, 15, 15, true},
+		{corefoundation.NumberDoubleType, 16, 16, true},
+		{corefoundation.NumberCGFloatType, 17, 17, true},
+	}
+	for _, tc := range testCases {
+		n, ok := corefoundation.CreateNumber(alloc, tc.typ, tc.v)
+		if !ok {
+			t.Fatalf("failed to create number of type %d", tc.typ)
+		}
+		if _, ok := corefoundation.AsNumber(n); !ok {
+			t.Errorf("type %d: not a number", tc.typ)
+		}
+		if got := corefoundation.NumberIsFloat(n); got != tc.float {
+			t.Errorf("type %d: expected NumberIsFloat %v, got %v", tc.typ, tc.float, got)
+		}
+		if got, exact := corefoundation.NumberInt64Value(n); got != tc.want || !exact {
+			t.Errorf("type %d: expected %d, got %d (exact %v)", tc.typ, tc.want, got, exact)
+		}
+		if got, _ := corefoundation.NumberFloat64Value(n); got != float64(tc.want) {
+			t.Errorf("type %d: expected %v, got %v", tc.typ, float64(tc.want), got)
+		}
+		corefoundation.Release(n)
+	}
+
+	if n, ok := corefoundation.CreateNumber(alloc, 0, 1); ok {
+		corefoundation.Release(n)
+		t.Error("expected an error for invalid number type")
+	}
+}
+
+func TestNumberLossyValue(t *testing.T) {
+	alloc := corefoundation.AllocatorDefault()
+
+	f, ok := corefoundation.CreateFloatNumber(alloc, corefoundation.NumberDoubleType, 2.5)
+	if !ok {
+		t.Fatal("failed to create a number")
+	}
+	defer corefoundation.Release(f)
+	if v, exact := corefoundation.NumberInt64Value(f); v != 2 || exact {
+		t.Errorf("expected lossy 2, got %d (exact %v)", v, exact)
+	}
+	if v, exact := corefoundation.NumberFloat64Value(f); v != 2.5 || !exact {
+		t.Errorf("expected exact 2.5, got %v (exact %v)", v, exact)
+	}
+
+	i, ok := corefoundation.CreateNumber(alloc, corefoundation.NumberSInt64Type, 1<<53+1)
+	if !ok {
+		t.Fatal("failed to create a number")
+	}
+	defer corefoundation.Release(i)
+	if _, exact := corefoundation.NumberFloat64Value(i); exact {
+		t.Error("expected lossy conversion to float64")
+	}
+	if typ := corefoundation.GetNumberType(i); typ != corefoundation.NumberSInt64Type {
+		t.Errorf("expected type %d, got %d", corefoundation.NumberSInt64Type, typ)
+	}
+}
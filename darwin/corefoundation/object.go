@@ -9,7 +9,6 @@ package corefoundation
 //  • https://developer.apple.com/documentation/corefoundation/cftype
 
 import (
-	"github.com/noncgo/x/darwin/internal/cabi"
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -36,13 +35,7 @@ type Object types.CFType
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521280-cfgetallocator
 func GetAllocator(v Object) Allocator {
-	var out uintptr
-	cabi.Call(
-		extern_CFGetAllocator_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(v.Pointer()),
-	)
-	return types.Pointer(out)
+	return cfGetAllocator(v)
 }
 
 // GetRetainCount returns the reference count of a Core Foundation object.
@@ -50,13 +43,7 @@ func GetAllocator(v Object) Allocator {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521288-cfgetretaincount
 func GetRetainCount(v Object) int {
-	var out int
-	cabi.Call(
-		extern_CFGetRetainCount_trampolineABI0,
-		cabi.OutInt(&out),
-		cabi.Uintptr(v.Pointer()),
-	)
-	return out
+	return cfGetRetainCount(v)
 }
 
 // Equal determines whether two Core Foundation objects are considered equal.
@@ -64,14 +51,7 @@ func GetRetainCount(v Object) int {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521287-cfequal
 func Equal(a, b Object) bool {
-	var out bool
-	cabi.Call(
-		extern_CFEqual_trampolineABI0,
-		cabi.OutBool(&out),
-		cabi.Uintptr(a.Pointer()),
-		cabi.Uintptr(b.Pointer()),
-	)
-	return out
+	return cfEqual(a, b)
 }
 
 // Hash returns a code that can be used to identify an object in a hashing
@@ -85,13 +65,7 @@ func Equal(a, b Object) bool {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521137-cfhash
 func Hash(v Object) HashCode {
-	var out uint
-	cabi.Call(
-		extern_CFHash_trampolineABI0,
-		cabi.OutUint(&out),
-		cabi.Uintptr(v.Pointer()),
-	)
-	return HashCode(out)
+	return HashCode(cfHash(v))
 }
 
 // CopyDescription returns a textual description of a Core Foundation object.
@@ -99,13 +73,7 @@ func Hash(v Object) HashCode {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521252-cfcopydescription
 func CopyDescription(v Object) String {
-	var out uintptr
-	cabi.Call(
-		extern_CFCopyDescription_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(v.Pointer()),
-	)
-	return types.Pointer(out)
+	return cfCopyDescription(v)
 }
 
 // CopyTypeIDDescription returns a textual description of a Core Foundation
@@ -114,13 +82,7 @@ func CopyDescription(v Object) String {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521220-cfcopytypeiddescription
 func CopyTypeIDDescription(v TypeID) String {
-	var out uintptr
-	cabi.Call(
-		extern_CFCopyTypeIDDescription_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uint(uint(v)),
-	)
-	return types.Pointer(out)
+	return cfCopyTypeIDDescription(uint(v))
 }
 
 // GetTypeID returns the unique ID of an opaque type to which a Core Foundation
@@ -129,13 +91,7 @@ func CopyTypeIDDescription(v TypeID) String {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521218-cfgettypeid
 func GetTypeID(v Object) TypeID {
-	var out uint
-	cabi.Call(
-		extern_CFGetTypeID_trampolineABI0,
-		cabi.OutUint(&out),
-		cabi.Uintptr(v.Pointer()),
-	)
-	return TypeID(out)
+	return TypeID(cfGetTypeID(v))
 }
 
 // Show prints a description of a Core Foundation object to stderr.
@@ -143,11 +99,7 @@ func GetTypeID(v Object) TypeID {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1541433-cfshow
 func Show(v Object) {
-	cabi.Call(
-		extern_CFShow_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(v.Pointer()),
-	)
+	cfShow(v)
 }
 
 // Retain increments the reference counter of the given object.
@@ -155,13 +107,7 @@ func Show(v Object) {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521269-cfretain
 func Retain(v Object) Object {
-	var out uintptr
-	cabi.Call(
-		extern_CFRetain_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(v.Pointer()),
-	)
-	return types.Pointer(out)
+	return cfRetain(v)
 }
 
 // Release decrements the reference counter of the given object. It releases
@@ -170,9 +116,5 @@ func Retain(v Object) Object {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1521153-cfrelease/
 func Release(v Object) {
-	cabi.Call(
-		extern_CFRelease_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(v.Pointer()),
-	)
+	cfRelease(v)
 }
@@ -0,0 +1,239 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file implements Perform functions using run loop sources since
+// CFRunLoopPerformBlock requires Objective-C blocks ABI.
+
+import (
+	"sync"
+)
+
+// performer delivers queued functions to a run loop mode using a source.
+type performer struct {
+	key     performerKey
+	runLoop RunLoop
+	source  RunLoopSource
+
+	mu     sync.Mutex
+	queue  []performRequest
+	closed bool
+}
+
+// performRequest is a function queued by Perform or PerformAndWait. If done
+// is not nil, it receives true after the function returns, or false if the
+// performer is closed before calling the function.
+type performRequest struct {
+	fn   func()
+	done chan<- bool
+}
+
+// performerKey identifies a run loop mode.
+type performerKey struct {
+	runLoop uintptr
+	mode    string
+}
+
+// performers holds the performer of each run loop mode that Perform was
+// called with. Performers retain their run loops until the source is removed
+// from the run loop mode, i.e. until the run loop is deallocated, so run loop
+// addresses are never reused while they are in the map.
+var performers struct {
+	sync.Mutex
+	m map[performerKey]*performer
+}
+
+// lookupPerformer returns the performer for the run loop mode, creating it on
+// the first use. It returns false if the source could not be created or added
+// to the run loop, e.g. if the thread of the run loop has exited.
+func lookupPerformer(r RunLoop, mode RunLoopMode) (*performer, bool) {
+	key := performerKey{r.Pointer(), GoString(mode)}
+	performers.Lock()
+	defer performers.Unlock()
+	if p, ok := performers.m[key]; ok && !p.isClosed() {
+		return p, true
+	}
+	p := &performer{key: key, runLoop: r}
+	s, ok := CreateRunLoopSource(AllocatorDefault(), 0, &RunLoopSourceCallbacks{
+		Cancel: func(RunLoop, RunLoopMode) {
+			p.close()
+		},
+		Perform: p.perform,
+	})
+	if !ok {
+		return nil, false
+	}
+	p.source = s
+	// Run loops ignore sources that are added while they are being
+	// deallocated.
+	AddSource(r, s, mode)
+	if !ContainsSource(r, s, mode) {
+		Release(s)
+		return nil, false
+	}
+	Retain(r)
+	if performers.m == nil {
+		performers.m = make(map[performerKey]*performer)
+	}
+	performers.m[key] = p
+	return p, true
+}
+
+// isClosed reports whether the source was removed from the run loop mode.
+func (p *performer) isClosed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.closed
+}
+
+// enqueue adds the request to the queue. It returns false if the performer is
+// closed.
+func (p *performer) enqueue(req performRequest) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return false
+	}
+	p.queue = append(p.queue, req)
+	return true
+}
+
+// perform calls queued functions in order. Functions that are queued while it
+// runs are called on the next iteration of the run loop.
+func (p *performer) perform() {
+	p.mu.Lock()
+	n := len(p.queue)
+	p.mu.Unlock()
+	p.drain(n)
+}
+
+// drain calls up to n functions from the head of the queue. Functions are
+// dequeued one by one, so that a nested drain, e.g. by PerformAndWait called
+// from a queued function, continues in order.
+func (p *performer) drain(n int) {
+	for ; n > 0; n-- {
+		p.mu.Lock()
+		if len(p.queue) == 0 {
+			p.mu.Unlock()
+			return
+		}
+		req := p.queue[0]
+		p.queue = p.queue[1:]
+		p.mu.Unlock()
+
+		req.fn()
+		if req.done != nil {
+			req.done <- true
+		}
+	}
+}
+
+// close is called when the source is removed from the run loop mode, usually
+// when the run loop is deallocated after its thread exits. It drops queued
+// functions, releases the run loop and removes the performer.
+func (p *performer) close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	queue := p.queue
+	p.queue = nil
+	p.mu.Unlock()
+
+	for _, req := range queue {
+		if req.done != nil {
+			req.done <- false
+		}
+	}
+	Release(p.source)
+	Release(p.runLoop)
+
+	// The run loop may be locked while its sources are removed, and
+	// lookupPerformer adds sources with performers locked, so the
+	// performer is removed asynchronously to avoid a deadlock. Closed
+	// performers are replaced on lookup in the meantime.
+	go func() {
+		performers.Lock()
+		defer performers.Unlock()
+		if performers.m[p.key] == p {
+			delete(performers.m, p.key)
+		}
+	}()
+}
+
+// Perform schedules fn to be called on the thread of the run loop when it runs
+// in the given mode, e.g. to schedule a stream from another goroutine. It
+// signals a source that is added to the run loop mode on the first use and
+// wakes up the run loop. Functions scheduled for the same run loop mode are
+// called in order.
+//
+// The run loop must run on a thread created by the Go runtime, e.g. on a
+// goroutine locked to its thread. It returns false if the source could not be
+// created or the run loop is being deallocated. Functions that are not called
+// before the run loop is deallocated are dropped.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/1542985-cfrunloopperformblock
+func Perform(r RunLoop, mode RunLoopMode, fn func()) bool {
+	return schedulePerform(r, mode, performRequest{fn: fn})
+}
+
+// schedulePerform queues the request to the performer of the run loop mode and
+// signals its source.
+func schedulePerform(r RunLoop, mode RunLoopMode, req performRequest) bool {
+	p, ok := lookupPerformer(r, mode)
+	if !ok || !p.enqueue(req) {
+		return false
+	}
+	SignalRunLoopSource(p.source)
+	WakeUpRunLoop(r)
+	return true
+}
+
+// PerformAndWait is like Perform but waits until fn returns. It returns false
+// if fn was not called, e.g. if the run loop was deallocated before calling fn.
+//
+// If it is called on the thread of the run loop while the run loop runs, e.g.
+// from another callback, it calls fn directly to avoid a deadlock, after the
+// functions that are already queued for the mode to preserve the order. In
+// that case, it returns false without calling fn if the run loop runs in a
+// mode that does not include the given mode.
+//
+// The thread of the run loop is only detected while the run loop runs with
+// RunCurrentRunLoop or RunCurrentRunLoopInMode. If the run loop runs by other
+// means, e.g. by C code or by the application event loop of the main thread,
+// a call on its thread blocks indefinitely, as well as a call for a mode the
+// run loop does not run in.
+func PerformAndWait(r RunLoop, mode RunLoopMode, fn func()) bool {
+	if isRunLoopThread(r) {
+		return performOnRunLoopThread(r, mode, fn)
+	}
+	done := make(chan bool, 1)
+	if !schedulePerform(r, mode, performRequest{fn: fn, done: done}) {
+		return false
+	}
+	return <-done
+}
+
+// performOnRunLoopThread calls fn on the thread of the run loop after the
+// functions queued for the mode if the run loop runs in the mode.
+func performOnRunLoopThread(r RunLoop, mode RunLoopMode, fn func()) bool {
+	p, ok := lookupPerformer(r, mode)
+	if !ok {
+		return false
+	}
+	current, ok := CopyCurrentRunLoopMode(r)
+	if !ok {
+		return false
+	}
+	defer Release(current)
+	if !ContainsSource(r, p.source, current) {
+		return false
+	}
+	p.perform()
+	fn()
+	return true
+}
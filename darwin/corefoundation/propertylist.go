@@ -0,0 +1,173 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFPropertyList APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfpropertylist-rbv
+
+import (
+	"unsafe"
+)
+
+// PropertyListFormat is a format of serialized property lists.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistformat
+type PropertyListFormat int
+
+const (
+	// PropertyListOpenStepFormat is the legacy OpenStep format. It can be
+	// read but not written.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistformat/openstepformat
+	PropertyListOpenStepFormat PropertyListFormat = 1
+
+	// PropertyListXMLFormat is the XML format version 1.0.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistformat/xmlformat_v1_0
+	PropertyListXMLFormat PropertyListFormat = 100
+
+	// PropertyListBinaryFormat is the binary format version 1.0.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistformat/binaryformat_v1_0
+	PropertyListBinaryFormat PropertyListFormat = 200
+)
+
+// PropertyListMutabilityOptions specifies the mutability of objects created
+// from serialized property lists.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistmutabilityoptions
+type PropertyListMutabilityOptions uint
+
+const (
+	// PropertyListImmutable specifies that all objects are immutable.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistmutabilityoptions/kcfpropertylistimmutable
+	PropertyListImmutable PropertyListMutabilityOptions = 0
+
+	// PropertyListMutableContainers specifies that arrays and dictionaries
+	// are mutable.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistmutabilityoptions/kcfpropertylistmutablecontainers
+	PropertyListMutableContainers PropertyListMutabilityOptions = 1
+
+	// PropertyListMutableContainersAndLeaves specifies that all objects are
+	// mutable.
+	//
+	// References
+	//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistmutabilityoptions/kcfpropertylistmutablecontainersandleaves
+	PropertyListMutableContainersAndLeaves PropertyListMutabilityOptions = 2
+)
+
+// CreatePropertyListData returns a Data object containing the property list
+// v serialized in the given format. The caller owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistcreatedata(_:_:_:_:_:)
+func CreatePropertyListData(alloc Allocator, v Object, format PropertyListFormat) (Data, error) {
+	var e errorOut
+	out := cfPropertyListCreateData(alloc, v, int(format), 0, e.ptr())
+	if out == 0 {
+		return nil, e.take("serialize property list")
+	}
+	return out, nil
+}
+
+// CreatePropertyListWithData returns a property list object deserialized from
+// the given Data object, and the format of the data. The caller owns the
+// returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistcreatewithdata(_:_:_:_:_:)
+func CreatePropertyListWithData(alloc Allocator, d Data, opts PropertyListMutabilityOptions) (Object, PropertyListFormat, error) {
+	var e errorOut
+	var format int
+	out := cfPropertyListCreateWithData(alloc, d, uint(opts), unsafe.Pointer(&format), e.ptr())
+	if out == 0 {
+		return nil, 0, e.take("deserialize property list")
+	}
+	return out, PropertyListFormat(format), nil
+}
+
+// WritePropertyList writes the property list v serialized in the given format
+// to an open stream. It returns the number of bytes written.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistwrite(_:_:_:_:_:)
+func WritePropertyList(v Object, w WriteStream, format PropertyListFormat) (int, error) {
+	var e errorOut
+	n := cfPropertyListWrite(v, w, int(format), 0, e.ptr())
+	if n == 0 {
+		return 0, e.take("write property list")
+	}
+	return n, nil
+}
+
+// CreatePropertyListWithStream returns a property list object read from an
+// open stream, and the format of the data. If length is zero, the stream is
+// read until its end, otherwise at most length bytes are read. The caller owns
+// the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistcreatewithstream(_:_:_:_:_:_:)
+func CreatePropertyListWithStream(alloc Allocator, r ReadStream, length int, opts PropertyListMutabilityOptions) (Object, PropertyListFormat, error) {
+	var e errorOut
+	var format int
+	out := cfPropertyListCreateWithStream(alloc, r, length, uint(opts), unsafe.Pointer(&format), e.ptr())
+	if out == 0 {
+		return nil, 0, e.take("read property list")
+	}
+	return out, PropertyListFormat(format), nil
+}
+
+// PropertyListIsValid reports whether v is a valid property list object that
+// can be serialized in the given format, i.e. consists only of String, Data,
+// Boolean, Number, Date, Array and Dictionary objects with String keys.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfpropertylistisvalid(_:_:)
+func PropertyListIsValid(v Object, format PropertyListFormat) bool {
+	return cfPropertyListIsValid(v, int(format))
+}
+
+// Marshal returns the Go value v serialized as a property list in the given
+// format. The value is converted using FromGo. Note that nil values convert
+// to Null, which is not a property list type.
+func Marshal(v any, format PropertyListFormat) ([]byte, error) {
+	obj, err := FromGo(v)
+	if err != nil {
+		return nil, err
+	}
+	defer Release(obj)
+	d, err := CreatePropertyListData(AllocatorDefault(), obj, format)
+	if err != nil {
+		return nil, err
+	}
+	defer Release(d)
+	return Bytes(d), nil
+}
+
+// Unmarshal parses a serialized property list in any supported format and
+// returns the Go value converted using ToGo.
+func Unmarshal(data []byte) (any, error) {
+	d := cfDataCreate(AllocatorDefault(), data, len(data))
+	if d == 0 {
+		return nil, &createError{"data"}
+	}
+	defer Release(d)
+	obj, _, err := CreatePropertyListWithData(AllocatorDefault(), d, PropertyListImmutable)
+	if err != nil {
+		return nil, err
+	}
+	defer Release(obj)
+	return ToGo(obj)
+}
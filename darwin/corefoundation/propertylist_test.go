@@ -0,0 +1,167 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	v := map[string]interface{}{
+		"name":  "example",
+		"count": int64(3),
+		"tags":  []interface{}{"a", "b"},
+		"raw":   []byte{0, 1, 2},
+	}
+	testCases := []struct {
+		name   string
+		format corefoundation.PropertyListFormat
+		prefix string
+	}{
+		{"XML", corefoundation.PropertyListXMLFormat, "<?xml"},
+		{"Binary", corefoundation.PropertyListBinaryFormat, "bplist00"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := corefoundation.Marshal(v, tc.format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.HasPrefix(b, []byte(tc.prefix)) {
+				t.Errorf("expected %q prefix, got %q", tc.prefix, b)
+			}
+			got, err := corefoundation.Unmarshal(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, v) {
+				t.Errorf("expected %#v, got %#v", v, got)
+			}
+		})
+	}
+}
+
+func TestMarshalErrors(t *testing.T) {
+	if _, err := corefoundation.Marshal([]interface{}{nil}, corefoundation.PropertyListXMLFormat); err == nil {
+		t.Error("expected an error for Null value")
+	}
+	if _, err := corefoundation.Marshal(make(chan int), corefoundation.PropertyListXMLFormat); err == nil {
+		t.Error("expected an error for unsupported type")
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	_, err := corefoundation.Unmarshal([]byte("<plist><dict><key>x</key></plist>"))
+	if err == nil {
+		t.Fatal("expected an error for malformed property list")
+	}
+	if !strings.HasPrefix(err.Error(), "corefoundation: ") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestPropertyListIsValid(t *testing.T) {
+	s := corefoundation.NewString("x")
+	defer corefoundation.Release(s)
+	if !corefoundation.PropertyListIsValid(s, corefoundation.PropertyListBinaryFormat) {
+		t.Error("String should be a valid property list")
+	}
+	if corefoundation.PropertyListIsValid(corefoundation.Null(), corefoundation.PropertyListXMLFormat) {
+		t.Error("Null should not be a valid property list")
+	}
+}
+
+func TestPropertyListMutability(t *testing.T) {
+	b, err := corefoundation.Marshal([]interface{}{"a"}, corefoundation.PropertyListBinaryFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, ok := corefoundation.CreateReadStreamWithBytes(corefoundation.AllocatorDefault(), b)
+	if !ok {
+		t.Fatal("failed to create a read stream")
+	}
+	defer corefoundation.Release(r)
+	if !corefoundation.OpenReadStream(r) {
+		t.Fatal("failed to open a read stream")
+	}
+	defer corefoundation.CloseReadStream(r)
+
+	obj, format, err := corefoundation.CreatePropertyListWithStream(
+		corefoundation.AllocatorDefault(),
+		r,
+		0,
+		corefoundation.PropertyListMutableContainers,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer corefoundation.Release(obj)
+	if format != corefoundation.PropertyListBinaryFormat {
+		t.Errorf("expected binary format, got %d", format)
+	}
+	a, ok := corefoundation.AsArray(obj)
+	if !ok {
+		t.Fatal("expected an array")
+	}
+	// Mutating an immutable array is a fatal error, so this only checks
+	// that the mutable container is usable.
+	m := a.(corefoundation.MutableArray)
+	s := corefoundation.NewString("b")
+	defer corefoundation.Release(s)
+	corefoundation.AppendToArray(m, s)
+	if n := corefoundation.ArrayCount(m); n != 2 {
+		t.Errorf("expected 2 values, got %d", n)
+	}
+}
+
+func TestWritePropertyList(t *testing.T) {
+	obj, err := corefoundation.FromGo(map[string]interface{}{"key": "value"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer corefoundation.Release(obj)
+
+	w, ok := corefoundation.CreateMemoryWriteStream(corefoundation.AllocatorDefault())
+	if !ok {
+		t.Fatal("failed to create a write stream")
+	}
+	defer corefoundation.Release(w)
+	if !corefoundation.OpenWriteStream(w) {
+		t.Fatal("failed to open a write stream")
+	}
+	n, err := corefoundation.WritePropertyList(obj, w, corefoundation.PropertyListXMLFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, ok := corefoundation.CopyWriteStreamData(w)
+	corefoundation.CloseWriteStream(w)
+	if !ok {
+		t.Fatal("failed to copy written data")
+	}
+	defer corefoundation.Release(d)
+	if m := corefoundation.GetDataLength(d); m != n {
+		t.Errorf("expected %d bytes written, got %d", n, m)
+	}
+
+	got, format, err := corefoundation.CreatePropertyListWithData(
+		corefoundation.AllocatorDefault(),
+		d,
+		corefoundation.PropertyListImmutable,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer corefoundation.Release(got)
+	if format != corefoundation.PropertyListXMLFormat {
+		t.Errorf("expected XML format, got %d", format)
+	}
+	if !corefoundation.Equal(got, obj) {
+		t.Error("expected the property list to round-trip")
+	}
+}
@@ -0,0 +1,22 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// Range is a structure representing a range of sequential items in a
+// container, such as characters in a string or elements in an array.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrange
+type Range struct {
+	// Location is the index of the first item in the range.
+	Location int
+	// Length is the number of items in the range.
+	Length int
+}
+
+// notFound is a value returned by search functions if the value is not found.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfnotfound
+const notFound = -1
@@ -9,10 +9,10 @@ package corefoundation
 //  • https://developer.apple.com/documentation/corefoundation/cfrunloop-rht
 
 import (
+	"runtime"
+	"sync"
 	"time"
-	"unsafe"
 
-	"github.com/noncgo/x/darwin/internal/cabi"
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -31,31 +31,6 @@ type RunLoop types.CFRunLoop
 //  • https://developer.apple.com/documentation/corefoundation/cfrunloop-rht
 type RunLoopMode = String
 
-// RunLoopDefaultMode returns the default mode of the run loop.
-//
-// References
-//  • https://developer.apple.com/documentation/corefoundation/kcfrunloopdefaultmode
-//  • https://developer.apple.com/documentation/corefoundation/cfrunloopmode
-//  • https://developer.apple.com/documentation/corefoundation/cfrunloop-rht
-func RunLoopDefaultMode() RunLoopMode {
-	addr := extern_kCFRunLoopDefaultMode_getAddr()
-	addr = **(**uintptr)(unsafe.Pointer(&addr))
-	return types.Pointer(addr)
-}
-
-// RunLoopCommonModes returns a special pseudo-mode that allows
-// associating more than one mode with a given run loop source.
-//
-// References
-//  • https://developer.apple.com/documentation/corefoundation/kcfrunloopcommonmodes
-//  • https://developer.apple.com/documentation/corefoundation/cfrunloopmode
-//  • https://developer.apple.com/documentation/corefoundation/cfrunloop-rht
-func RunLoopCommonModes() RunLoopMode {
-	addr := extern_kCFRunLoopCommonModes_getAddr()
-	addr = **(**uintptr)(unsafe.Pointer(&addr))
-	return types.Pointer(addr)
-}
-
 // RunLoopResult identifies the reason run loop exited RunCurrentRunLoopInMode.
 //
 // References
@@ -100,12 +75,7 @@ const (
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1542890-cfrunloopgetmain
 func GetMainRunLoop() RunLoop {
-	var out uintptr
-	cabi.Call(
-		extern_CFRunLoopGetMain_trampolineABI0,
-		cabi.OutUintptr(&out),
-	)
-	return types.Pointer(out)
+	return cfRunLoopGetMain()
 }
 
 // GetCurrentRunLoop returns the RunLoop object for the current thread.
@@ -113,12 +83,19 @@ func GetMainRunLoop() RunLoop {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1542428-cfrunloopgetcurrent
 func GetCurrentRunLoop() RunLoop {
-	var out uintptr
-	cabi.Call(
-		extern_CFRunLoopGetCurrent_trampolineABI0,
-		cabi.OutUintptr(&out),
-	)
-	return types.Pointer(out)
+	return cfRunLoopGetCurrent()
+}
+
+// CopyCurrentRunLoopMode returns the mode in which the run loop is running. It
+// returns false if the run loop is not running.
+//
+// The caller owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopcopycurrentmode(_:)
+func CopyCurrentRunLoopMode(r RunLoop) (RunLoopMode, bool) {
+	out := cfRunLoopCopyCurrentMode(r)
+	return out, out != 0
 }
 
 // RunCurrentRunLoopInMode runs the current thread’s RunLoop object in a
@@ -127,15 +104,8 @@ func GetCurrentRunLoop() RunLoop {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1541988-cfrunloopruninmode
 func RunCurrentRunLoopInMode(mode RunLoopMode, d time.Duration, returnAfterSourceHandled bool) RunLoopResult {
-	var out int32
-	cabi.Call(
-		extern_CFRunLoopRunInMode_trampolineABI0,
-		cabi.OutInt32(&out),
-		cabi.Uintptr(mode.Pointer()),
-		cabi.Float64(d.Seconds()),
-		cabi.Bool(returnAfterSourceHandled),
-	)
-	return RunLoopResult(out)
+	defer enterRunLoop()()
+	return RunLoopResult(cfRunLoopRunInMode(mode, d.Seconds(), returnAfterSourceHandled))
 }
 
 // RunCurrentRunLoop runs the current thread’s RunLoop object in its default
@@ -144,10 +114,68 @@ func RunCurrentRunLoopInMode(mode RunLoopMode, d time.Duration, returnAfterSourc
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1542011-cfrunlooprun
 func RunCurrentRunLoop() {
-	cabi.Call(
-		extern_CFRunLoopRun_trampolineABI0,
-		cabi.Void(),
-	)
+	defer enterRunLoop()()
+	cfRunLoopRun()
+}
+
+// runLoopThread is the thread that runs a run loop.
+type runLoopThread struct {
+	thread uintptr // pthread_t
+	depth  int     // number of nested activations
+}
+
+// runningRunLoops maps run loops that are running on Go threads to their
+// threads, so that PerformAndWait can tell whether it is called on the thread
+// of a run loop without creating a run loop for the calling thread.
+var runningRunLoops struct {
+	sync.Mutex
+	m map[uintptr]*runLoopThread
+}
+
+// enterRunLoop records that the current thread runs its run loop and locks
+// the calling goroutine to the thread. It returns a function that undoes it
+// when the run loop exits.
+func enterRunLoop() func() {
+	runtime.LockOSThread()
+	r := GetCurrentRunLoop().Pointer()
+	runningRunLoops.Lock()
+	t, ok := runningRunLoops.m[r]
+	if !ok {
+		t = &runLoopThread{thread: pthread_self()}
+		if runningRunLoops.m == nil {
+			runningRunLoops.m = make(map[uintptr]*runLoopThread)
+		}
+		runningRunLoops.m[r] = t
+	}
+	t.depth++
+	runningRunLoops.Unlock()
+	return func() {
+		runningRunLoops.Lock()
+		t.depth--
+		if t.depth == 0 {
+			delete(runningRunLoops.m, r)
+		}
+		runningRunLoops.Unlock()
+		runtime.UnlockOSThread()
+	}
+}
+
+// isRunLoopThread reports whether the calling goroutine runs on the thread of
+// the run loop while it is running.
+func isRunLoopThread(r RunLoop) bool {
+	runningRunLoops.Lock()
+	t, ok := runningRunLoops.m[r.Pointer()]
+	var thread uintptr
+	if ok {
+		thread = t.thread
+	}
+	runningRunLoops.Unlock()
+	if !ok {
+		return false
+	}
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	return pthread_self() == thread
 }
 
 // WakeUpRunLoop wakes a waiting RunLoop object.
@@ -155,11 +183,7 @@ func RunCurrentRunLoop() {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1541622-cfrunloopwakeup
 func WakeUpRunLoop(r RunLoop) {
-	cabi.Call(
-		extern_CFRunLoopRun_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(r.Pointer()),
-	)
+	cfRunLoopWakeUp(r)
 }
 
 // StopRunLoop forces a RunLoop object to stop running.
@@ -170,9 +194,5 @@ func WakeUpRunLoop(r RunLoop) {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1541796-cfrunloopstop
 func StopRunLoop(r RunLoop) {
-	cabi.Call(
-		extern_CFRunLoopStop_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(r.Pointer()),
-	)
+	cfRunLoopStop(r)
 }
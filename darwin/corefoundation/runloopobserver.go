@@ -0,0 +1,252 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFRunLoopObserver APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobserver-rhm
+
+import (
+	"math"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/callback"
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// RunLoopObserver is an opaque reference to a CFRunLoopObserver type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobserver
+type RunLoopObserver types.CFRunLoopObserver
+
+// runLoopObserverContext is the CFRunLoopObserverContext structure.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobservercontext
+type runLoopObserverContext struct {
+	Version         int
+	Info            uintptr
+	Retain          uintptr
+	Release         uintptr
+	CopyDescription uintptr
+}
+
+// goRunLoopObservers holds the shared C callbacks and the functions of
+// observers created with CreateRunLoopObserver.
+var goRunLoopObservers struct {
+	once    sync.Once
+	ctxt    runLoopObserverContext
+	callout uintptr
+	err     error
+	infos   contextInfos
+}
+
+// CreateRunLoopObserver creates an observer that calls fn on the thread of a
+// run loop when it reaches one of the given activity stages in a mode that the
+// observer is added to. If repeats is false, the observer is invalidated after
+// the first call. The order determines the priority of the observer among
+// observers of a run loop mode, observers with lower order are called first.
+// The function is released along with the observer.
+//
+// Callbacks are called from C using internal/callback package, so the run
+// loop must run on a thread created by the Go runtime, e.g. on a goroutine
+// locked to its thread.
+//
+// If there was a problem creating the object, it returns false. The caller
+// owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobservercreate(_:_:_:_:_:_:)
+func CreateRunLoopObserver(alloc Allocator, activities RunLoopActivity, repeats bool, order int, fn func(o RunLoopObserver, activity RunLoopActivity)) (RunLoopObserver, bool) {
+	gob := &goRunLoopObservers
+	gob.once.Do(initGoRunLoopObservers)
+	if gob.err != nil {
+		return nil, false
+	}
+
+	ctxt := gob.ctxt
+	ctxt.Info = gob.infos.add(fn)
+	out := cfRunLoopObserverCreate(alloc, uint(activities), repeats, order, gob.callout, unsafe.Pointer(&ctxt))
+	if out == 0 {
+		gob.infos.remove(ctxt.Info)
+		return nil, false
+	}
+	return out, true
+}
+
+func initGoRunLoopObservers() {
+	gob := &goRunLoopObservers
+	gob.err = newCallbacks([]callbackSlot{
+		// void release(const void *info)
+		{&gob.ctxt.Release, func(args callback.Args) uintptr {
+			gob.infos.remove(args[0])
+			return 0
+		}},
+		// void callout(CFRunLoopObserverRef observer, CFRunLoopActivity activity, void *info)
+		{&gob.callout, func(args callback.Args) uintptr {
+			fn := gob.infos.get(args[2]).(func(RunLoopObserver, RunLoopActivity))
+			fn(types.Pointer(args[0]), RunLoopActivity(args[1]))
+			return 0
+		}},
+	})
+}
+
+// AddObserver adds the observer to a run loop mode. The run loop retains the
+// observer. An observer can be added to a single run loop at a time, but to
+// multiple modes of it. If mode is RunLoopCommonModes, the observer is added
+// to all common modes.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopaddobserver(_:_:_:)
+func AddObserver(r RunLoop, o RunLoopObserver, mode RunLoopMode) {
+	cfRunLoopAddObserver(r, o, mode)
+}
+
+// RemoveObserver removes the observer from a run loop mode and releases it.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopremoveobserver(_:_:_:)
+func RemoveObserver(r RunLoop, o RunLoopObserver, mode RunLoopMode) {
+	cfRunLoopRemoveObserver(r, o, mode)
+}
+
+// ContainsObserver reports whether the run loop mode contains the observer.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopcontainsobserver(_:_:_:)
+func ContainsObserver(r RunLoop, o RunLoopObserver, mode RunLoopMode) bool {
+	return cfRunLoopContainsObserver(r, o, mode)
+}
+
+// InvalidateRunLoopObserver invalidates the observer and removes it from all
+// run loop modes. An invalidated observer is never called again.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobserverinvalidate(_:)
+func InvalidateRunLoopObserver(o RunLoopObserver) {
+	cfRunLoopObserverInvalidate(o)
+}
+
+// IsRunLoopObserverValid reports whether the observer is valid, i.e. it was
+// not invalidated.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobserverisvalid(_:)
+func IsRunLoopObserverValid(o RunLoopObserver) bool {
+	return cfRunLoopObserverIsValid(o)
+}
+
+// RunLoopObserverActivities returns the activity stages that the observer
+// observes.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobservergetactivities(_:)
+func RunLoopObserverActivities(o RunLoopObserver) RunLoopActivity {
+	return RunLoopActivity(cfRunLoopObserverGetActivities(o))
+}
+
+// RunLoopObserverDoesRepeat reports whether the observer is called repeatedly.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobserverdoesrepeat(_:)
+func RunLoopObserverDoesRepeat(o RunLoopObserver) bool {
+	return cfRunLoopObserverDoesRepeat(o)
+}
+
+// RunLoopObserverOrder returns the order of the observer given on creation.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopobservergetorder(_:)
+func RunLoopObserverOrder(o RunLoopObserver) int {
+	return cfRunLoopObserverGetOrder(o)
+}
+
+// RunLoopIteration is the timing of a run loop iteration, i.e. a busy period
+// of processing timers and sources followed by an idle period of waiting for
+// them to fire.
+type RunLoopIteration struct {
+	// Start is the time the run loop was entered or woke up.
+	Start time.Time
+	// Busy is the time spent processing timers and sources.
+	Busy time.Duration
+	// Idle is the time spent waiting. It is zero for the last iteration
+	// before the run loop exits.
+	Idle time.Duration
+}
+
+// runLoopTiming is the state of a timing observer for an activation of a run
+// loop.
+type runLoopTiming struct {
+	start     time.Time
+	waitStart time.Time
+	waiting   bool
+}
+
+// CreateRunLoopTimingObservers creates a pair of observers that record busy
+// and idle time of run loop iterations, e.g. to diagnose stalls of callbacks.
+// They call fn on the thread of the run loop after the run loop wakes up or
+// exits. Nested activations of the run loop are recorded separately.
+//
+// The first observer has the lowest order and observes entering and waking up,
+// so that the busy period includes other observers called after waking up.
+// The last observer has the highest order and observes waiting and exiting,
+// so that the busy period includes other observers called before waiting.
+// Both observers must be added to the same run loop modes.
+//
+// If there was a problem creating the objects, it returns false. The caller
+// owns the returned references.
+func CreateRunLoopTimingObservers(alloc Allocator, fn func(it RunLoopIteration)) (first, last RunLoopObserver, ok bool) {
+	// The stack is accessed only from the run loop thread.
+	var stack []runLoopTiming
+	top := func(now time.Time) *runLoopTiming {
+		if len(stack) == 0 {
+			// The observers were added while the run loop was running.
+			stack = append(stack, runLoopTiming{start: now})
+		}
+		return &stack[len(stack)-1]
+	}
+
+	first, ok = CreateRunLoopObserver(alloc, RunLoopActivityEntry|RunLoopActivityAfterWaiting, true, math.MinInt, func(_ RunLoopObserver, activity RunLoopActivity) {
+		now := time.Now()
+		if activity == RunLoopActivityEntry {
+			stack = append(stack, runLoopTiming{start: now})
+			return
+		}
+		t := top(now)
+		if !t.waiting {
+			t.waitStart = now
+		}
+		fn(RunLoopIteration{
+			Start: t.start,
+			Busy:  t.waitStart.Sub(t.start),
+			Idle:  now.Sub(t.waitStart),
+		})
+		*t = runLoopTiming{start: now}
+	})
+	if !ok {
+		return nil, nil, false
+	}
+	last, ok = CreateRunLoopObserver(alloc, RunLoopActivityBeforeWaiting|RunLoopActivityExit, true, math.MaxInt, func(_ RunLoopObserver, activity RunLoopActivity) {
+		now := time.Now()
+		t := top(now)
+		if activity == RunLoopActivityBeforeWaiting {
+			t.waitStart, t.waiting = now, true
+			return
+		}
+		stack = stack[:len(stack)-1]
+		fn(RunLoopIteration{
+			Start: t.start,
+			Busy:  now.Sub(t.start),
+		})
+	})
+	if !ok {
+		Release(first)
+		return nil, nil, false
+	}
+	return first, last, true
+}
@@ -0,0 +1,114 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestRunLoopObserver(t *testing.T) {
+	l := startRunLoop(t)
+	defer l.stop()
+	mode := corefoundation.RunLoopDefaultMode()
+
+	activities := make(chan corefoundation.RunLoopActivity, 100)
+	o, ok := corefoundation.CreateRunLoopObserver(
+		corefoundation.AllocatorDefault(),
+		corefoundation.RunLoopActivityBeforeWaiting|corefoundation.RunLoopActivityAfterWaiting,
+		true,
+		1,
+		func(_ corefoundation.RunLoopObserver, activity corefoundation.RunLoopActivity) {
+			select {
+			case activities <- activity:
+			default:
+			}
+		},
+	)
+	if !ok {
+		t.Fatal("failed to create observer")
+	}
+	defer corefoundation.Release(o)
+	if a := corefoundation.RunLoopObserverActivities(o); a.String() != "BeforeWaiting|AfterWaiting" {
+		t.Errorf("unexpected activities %v", a)
+	}
+	if !corefoundation.RunLoopObserverDoesRepeat(o) || corefoundation.RunLoopObserverOrder(o) != 1 {
+		t.Error("unexpected observer properties")
+	}
+
+	corefoundation.AddObserver(l.r, o, mode)
+	if !corefoundation.ContainsObserver(l.r, o, mode) {
+		t.Error("expected the run loop to contain the observer")
+	}
+	corefoundation.PerformAndWait(l.r, mode, func() {})
+	select {
+	case a := <-activities:
+		if a != corefoundation.RunLoopActivityBeforeWaiting && a != corefoundation.RunLoopActivityAfterWaiting {
+			t.Errorf("unexpected activity %v", a)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the observer")
+	}
+
+	corefoundation.RemoveObserver(l.r, o, mode)
+	if corefoundation.ContainsObserver(l.r, o, mode) {
+		t.Error("expected the observer to be removed")
+	}
+	corefoundation.InvalidateRunLoopObserver(o)
+	if corefoundation.IsRunLoopObserverValid(o) {
+		t.Error("expected the observer to be invalidated")
+	}
+}
+
+func TestRunLoopTimingObservers(t *testing.T) {
+	l := startRunLoop(t)
+	defer l.stop()
+	mode := corefoundation.RunLoopDefaultMode()
+
+	iterations := make(chan corefoundation.RunLoopIteration, 100)
+	first, last, ok := corefoundation.CreateRunLoopTimingObservers(corefoundation.AllocatorDefault(), func(it corefoundation.RunLoopIteration) {
+		select {
+		case iterations <- it:
+		default:
+		}
+	})
+	if !ok {
+		t.Fatal("failed to create observers")
+	}
+	for _, o := range []corefoundation.RunLoopObserver{first, last} {
+		defer corefoundation.Release(o)
+		corefoundation.AddObserver(l.r, o, mode)
+		defer corefoundation.InvalidateRunLoopObserver(o)
+	}
+	if got := corefoundation.RunLoopObserverOrder(first); got != math.MinInt {
+		t.Errorf("expected the lowest order of the first observer, got %d", got)
+	}
+	if got := corefoundation.RunLoopObserverOrder(last); got != math.MaxInt {
+		t.Errorf("expected the highest order of the last observer, got %d", got)
+	}
+
+	// Keep the run loop busy for a while. Then it waits until the timeout
+	// of RunCurrentRunLoopInMode at the latest.
+	corefoundation.PerformAndWait(l.r, mode, func() {
+		time.Sleep(50 * time.Millisecond)
+	})
+
+	var busy, idle time.Duration
+	deadline := time.After(10 * time.Second)
+	for busy < 50*time.Millisecond || idle < 50*time.Millisecond {
+		select {
+		case it := <-iterations:
+			if it.Busy < 0 || it.Idle < 0 || it.Start.IsZero() {
+				t.Errorf("invalid iteration %+v", it)
+			}
+			busy += it.Busy
+			idle += it.Idle
+		case <-deadline:
+			t.Fatalf("timed out with busy %v and idle %v", busy, idle)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFRunLoopSource APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsource-rhr
+
+import (
+	"sync"
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/callback"
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// RunLoopSource is an opaque reference to a CFRunLoopSource type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsource
+type RunLoopSource types.CFRunLoopSource
+
+// RunLoopSourceCallbacks is a structure containing the callbacks of a version 0
+// run loop source created with CreateRunLoopSource.
+//
+// Callbacks are called from C using internal/callback package, so the run
+// loop must run on a thread created by the Go runtime, e.g. on a goroutine
+// locked to its thread. A nil callback has the same meaning as NULL function
+// pointer in C.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsourcecontext
+type RunLoopSourceCallbacks struct {
+	// Schedule is called when the source is added to a run loop mode.
+	Schedule func(r RunLoop, mode RunLoopMode)
+	// Cancel is called when the source is removed from a run loop mode,
+	// either explicitly or when the source is invalidated.
+	Cancel func(r RunLoop, mode RunLoopMode)
+	// Perform is called on the thread of a run loop that runs in one of
+	// the source modes after the source is signaled.
+	Perform func()
+	// Release is called when the source is deallocated. No other callbacks
+	// are called after it.
+	Release func()
+}
+
+// runLoopSourceContext is the CFRunLoopSourceContext structure.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsourcecontext
+type runLoopSourceContext struct {
+	Version         int
+	Info            uintptr
+	Retain          uintptr
+	Release         uintptr
+	CopyDescription uintptr
+	Equal           uintptr
+	Hash            uintptr
+	Schedule        uintptr
+	Cancel          uintptr
+	Perform         uintptr
+}
+
+// goRunLoopSources holds the shared C callbacks and the state of sources
+// created with CreateRunLoopSource.
+var goRunLoopSources struct {
+	once  sync.Once
+	ctxt  runLoopSourceContext
+	err   error
+	infos contextInfos
+}
+
+// CreateRunLoopSource creates a version 0 run loop source with Go callbacks.
+// The order determines the priority of the source among sources of a run loop
+// mode, sources with lower order are processed first. The callbacks are
+// copied, so later changes to the structure do not affect the source.
+//
+// If there was a problem creating the object, it returns false. The caller
+// owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsourcecreate(_:_:_:)
+func CreateRunLoopSource(alloc Allocator, order int, callbacks *RunLoopSourceCallbacks) (RunLoopSource, bool) {
+	gs := &goRunLoopSources
+	gs.once.Do(initGoRunLoopSources)
+	if gs.err != nil {
+		return nil, false
+	}
+
+	cb := *callbacks
+	ctxt := gs.ctxt
+	ctxt.Info = gs.infos.add(&cb)
+	out := cfRunLoopSourceCreate(alloc, order, unsafe.Pointer(&ctxt))
+	if out == 0 {
+		gs.infos.remove(ctxt.Info)
+		return nil, false
+	}
+	return out, true
+}
+
+// lookupGoRunLoopSource returns the callbacks for the context info.
+func lookupGoRunLoopSource(info uintptr) *RunLoopSourceCallbacks {
+	return goRunLoopSources.infos.get(info).(*RunLoopSourceCallbacks)
+}
+
+func initGoRunLoopSources() {
+	gs := &goRunLoopSources
+	gs.err = newCallbacks([]callbackSlot{
+		// void release(const void *info)
+		{&gs.ctxt.Release, func(args callback.Args) uintptr {
+			cb := gs.infos.remove(args[0]).(*RunLoopSourceCallbacks)
+			if cb.Release != nil {
+				cb.Release()
+			}
+			return 0
+		}},
+		// void schedule(void *info, CFRunLoopRef rl, CFRunLoopMode mode)
+		{&gs.ctxt.Schedule, func(args callback.Args) uintptr {
+			cb := lookupGoRunLoopSource(args[0])
+			if cb.Schedule != nil {
+				cb.Schedule(types.Pointer(args[1]), types.Pointer(args[2]))
+			}
+			return 0
+		}},
+		// void cancel(void *info, CFRunLoopRef rl, CFRunLoopMode mode)
+		{&gs.ctxt.Cancel, func(args callback.Args) uintptr {
+			cb := lookupGoRunLoopSource(args[0])
+			if cb.Cancel != nil {
+				cb.Cancel(types.Pointer(args[1]), types.Pointer(args[2]))
+			}
+			return 0
+		}},
+		// void perform(void *info)
+		{&gs.ctxt.Perform, func(args callback.Args) uintptr {
+			cb := lookupGoRunLoopSource(args[0])
+			if cb.Perform != nil {
+				cb.Perform()
+			}
+			return 0
+		}},
+	})
+}
+
+// SignalRunLoopSource marks the source as ready to fire. The Perform callback
+// is called on the next iteration of a run loop that runs in one of the source
+// modes. Use WakeUpRunLoop to process the source if the run loop is waiting.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsourcesignal(_:)
+func SignalRunLoopSource(s RunLoopSource) {
+	cfRunLoopSourceSignal(s)
+}
+
+// InvalidateRunLoopSource invalidates the source and removes it from all run
+// loop modes. An invalidated source never fires again.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsourceinvalidate(_:)
+func InvalidateRunLoopSource(s RunLoopSource) {
+	cfRunLoopSourceInvalidate(s)
+}
+
+// IsRunLoopSourceValid reports whether the source is valid, i.e. it was not
+// invalidated.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsourceisvalid(_:)
+func IsRunLoopSourceValid(s RunLoopSource) bool {
+	return cfRunLoopSourceIsValid(s)
+}
+
+// RunLoopSourceOrder returns the order of the source given on creation.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopsourcegetorder(_:)
+func RunLoopSourceOrder(s RunLoopSource) int {
+	return cfRunLoopSourceGetOrder(s)
+}
+
+// AddSource adds the source to a run loop mode. The run loop retains the
+// source. If mode is RunLoopCommonModes, the source is added to all common
+// modes.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopaddsource(_:_:_:)
+func AddSource(r RunLoop, s RunLoopSource, mode RunLoopMode) {
+	cfRunLoopAddSource(r, s, mode)
+}
+
+// RemoveSource removes the source from a run loop mode and releases it.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopremovesource(_:_:_:)
+func RemoveSource(r RunLoop, s RunLoopSource, mode RunLoopMode) {
+	cfRunLoopRemoveSource(r, s, mode)
+}
+
+// ContainsSource reports whether the run loop mode contains the source.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopcontainssource(_:_:_:)
+func ContainsSource(r RunLoop, s RunLoopSource, mode RunLoopMode) bool {
+	return cfRunLoopContainsSource(r, s, mode)
+}
@@ -0,0 +1,214 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+// testRunLoop is a run loop that runs in the default mode on its own thread.
+type testRunLoop struct {
+	r          corefoundation.RunLoop
+	stopSource corefoundation.RunLoopSource
+	done       chan struct{}
+}
+
+// startRunLoop runs a run loop on a new thread until stop is called.
+func startRunLoop(t *testing.T) *testRunLoop {
+	c := make(chan *testRunLoop)
+	go func() {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		mode := corefoundation.RunLoopDefaultMode()
+		stopped := false
+		// The source also keeps the mode non-empty so that the run
+		// loop does not finish immediately.
+		s, ok := corefoundation.CreateRunLoopSource(corefoundation.AllocatorDefault(), 0, &corefoundation.RunLoopSourceCallbacks{
+			Perform: func() {
+				stopped = true
+			},
+		})
+		if !ok {
+			t.Error("failed to create run loop source")
+			close(c)
+			return
+		}
+		l := &testRunLoop{
+			r:          corefoundation.GetCurrentRunLoop(),
+			stopSource: s,
+			done:       make(chan struct{}),
+		}
+		defer close(l.done)
+		corefoundation.AddSource(l.r, s, mode)
+		c <- l
+		for !stopped {
+			corefoundation.RunCurrentRunLoopInMode(mode, time.Second, false)
+		}
+		corefoundation.InvalidateRunLoopSource(s)
+		corefoundation.Release(s)
+	}()
+	l, ok := <-c
+	if !ok {
+		t.FailNow()
+	}
+	return l
+}
+
+// stop stops the run loop and waits until its thread exits.
+func (l *testRunLoop) stop() {
+	corefoundation.SignalRunLoopSource(l.stopSource)
+	corefoundation.WakeUpRunLoop(l.r)
+	<-l.done
+}
+
+func TestRunLoopSource(t *testing.T) {
+	l := startRunLoop(t)
+	defer l.stop()
+	mode := corefoundation.RunLoopDefaultMode()
+
+	var scheduled, canceled int
+	performed := make(chan struct{}, 1)
+	released := make(chan struct{})
+	s, ok := corefoundation.CreateRunLoopSource(corefoundation.AllocatorDefault(), 1, &corefoundation.RunLoopSourceCallbacks{
+		Schedule: func(r corefoundation.RunLoop, m corefoundation.RunLoopMode) {
+			if r.Pointer() != l.r.Pointer() || corefoundation.GoString(m) != corefoundation.GoString(mode) {
+				t.Error("unexpected run loop mode in Schedule")
+			}
+			scheduled++
+		},
+		Cancel: func(corefoundation.RunLoop, corefoundation.RunLoopMode) {
+			canceled++
+		},
+		Perform: func() {
+			performed <- struct{}{}
+		},
+		Release: func() {
+			close(released)
+		},
+	})
+	if !ok {
+		t.Fatal("failed to create run loop source")
+	}
+	if n := corefoundation.RunLoopSourceOrder(s); n != 1 {
+		t.Errorf("expected order 1, got %d", n)
+	}
+
+	corefoundation.AddSource(l.r, s, mode)
+	if scheduled != 1 {
+		t.Errorf("expected Schedule to be called once, got %d", scheduled)
+	}
+	if !corefoundation.ContainsSource(l.r, s, mode) {
+		t.Error("expected the run loop to contain the source")
+	}
+	corefoundation.SignalRunLoopSource(s)
+	corefoundation.WakeUpRunLoop(l.r)
+	select {
+	case <-performed:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for Perform")
+	}
+
+	corefoundation.InvalidateRunLoopSource(s)
+	if canceled != 1 {
+		t.Errorf("expected Cancel to be called once, got %d", canceled)
+	}
+	if corefoundation.IsRunLoopSourceValid(s) || corefoundation.ContainsSource(l.r, s, mode) {
+		t.Error("expected the source to be invalidated and removed")
+	}
+	// The run loop thread may still hold a reference after Perform.
+	corefoundation.Release(s)
+	select {
+	case <-released:
+	case <-time.After(10 * time.Second):
+		t.Error("timed out waiting for Release")
+	}
+}
+
+func TestPerform(t *testing.T) {
+	l := startRunLoop(t)
+	defer l.stop()
+	mode := corefoundation.RunLoopDefaultMode()
+
+	var got []int
+	for i := 0; i < 100; i++ {
+		i := i
+		if !corefoundation.Perform(l.r, mode, func() {
+			got = append(got, i)
+		}) {
+			t.Fatal("failed to perform")
+		}
+	}
+	current := false
+	if !corefoundation.PerformAndWait(l.r, mode, func() {
+		current = corefoundation.GetCurrentRunLoop().Pointer() == l.r.Pointer()
+		// Nested calls on the run loop thread do not deadlock.
+		corefoundation.PerformAndWait(l.r, mode, func() {
+			got = append(got, -1)
+		})
+	}) {
+		t.Fatal("failed to perform and wait")
+	}
+	if !current {
+		t.Error("expected the function to be called on the run loop thread")
+	}
+	if len(got) != 101 || got[100] != -1 {
+		t.Fatalf("unexpected calls %v", got)
+	}
+	for i, v := range got[:100] {
+		if v != i {
+			t.Fatalf("functions called out of order: %v", got)
+		}
+	}
+}
+
+func TestPerformAndWaitOrder(t *testing.T) {
+	l := startRunLoop(t)
+	defer l.stop()
+	mode := corefoundation.RunLoopDefaultMode()
+	otherMode := corefoundation.NewString("TestPerformAndWaitOrder")
+	defer corefoundation.Release(otherMode)
+
+	var got []string
+	done := make(chan struct{})
+	// Queue functions from the run loop thread so that the order does not
+	// depend on the scheduling of the test goroutine.
+	corefoundation.Perform(l.r, mode, func() {
+		corefoundation.Perform(l.r, mode, func() {
+			got = append(got, "a")
+			corefoundation.Perform(l.r, mode, func() {
+				got = append(got, "c")
+			})
+			if !corefoundation.PerformAndWait(l.r, mode, func() {
+				got = append(got, "d")
+			}) {
+				t.Error("failed to perform and wait on the run loop thread")
+			}
+			if corefoundation.PerformAndWait(l.r, otherMode, func() {
+				got = append(got, "other")
+			}) {
+				t.Error("expected no call for a mode the run loop does not run in")
+			}
+			got = append(got, "e")
+			close(done)
+		})
+		corefoundation.Perform(l.r, mode, func() {
+			got = append(got, "b")
+		})
+	})
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Perform")
+	}
+	if want := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got calls %v, want %v", got, want)
+	}
+}
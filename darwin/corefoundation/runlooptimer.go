@@ -0,0 +1,200 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFRunLoopTimer APIs.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimer-rhk
+
+import (
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/callback"
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// RunLoopTimer is an opaque reference to a CFRunLoopTimer type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimer
+type RunLoopTimer types.CFRunLoopTimer
+
+// runLoopTimerContext is the CFRunLoopTimerContext structure.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimercontext
+type runLoopTimerContext struct {
+	Version         int
+	Info            uintptr
+	Retain          uintptr
+	Release         uintptr
+	CopyDescription uintptr
+}
+
+// goRunLoopTimers holds the shared C callbacks and the functions of timers
+// created with CreateRunLoopTimer.
+var goRunLoopTimers struct {
+	once    sync.Once
+	ctxt    runLoopTimerContext
+	callout uintptr
+	err     error
+	infos   contextInfos
+}
+
+// CreateRunLoopTimer creates a timer that calls fn on the thread of a run loop
+// that the timer is added to. It first fires at fireDate, and then every
+// interval if it is positive. Otherwise, the timer fires once and is
+// invalidated automatically. The function is released along with the timer.
+//
+// Callbacks are called from C using internal/callback package, so the run
+// loop must run on a thread created by the Go runtime, e.g. on a goroutine
+// locked to its thread.
+//
+// If there was a problem creating the object, it returns false. The caller
+// owns the returned reference.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimercreate(_:_:_:_:_:_:_:)
+func CreateRunLoopTimer(alloc Allocator, fireDate time.Time, interval time.Duration, fn func(t RunLoopTimer)) (RunLoopTimer, bool) {
+	gt := &goRunLoopTimers
+	gt.once.Do(initGoRunLoopTimers)
+	if gt.err != nil {
+		return nil, false
+	}
+
+	ctxt := gt.ctxt
+	ctxt.Info = gt.infos.add(fn)
+	// The flags and order parameters are ignored by Core Foundation.
+	out := cfRunLoopTimerCreate(alloc, float64(AbsoluteTimeOf(fireDate)), interval.Seconds(), 0, 0, gt.callout, unsafe.Pointer(&ctxt))
+	if out == 0 {
+		gt.infos.remove(ctxt.Info)
+		return nil, false
+	}
+	return out, true
+}
+
+func initGoRunLoopTimers() {
+	gt := &goRunLoopTimers
+	gt.err = newCallbacks([]callbackSlot{
+		// void release(const void *info)
+		{&gt.ctxt.Release, func(args callback.Args) uintptr {
+			gt.infos.remove(args[0])
+			return 0
+		}},
+		// void callout(CFRunLoopTimerRef timer, void *info)
+		{&gt.callout, func(args callback.Args) uintptr {
+			fn := gt.infos.get(args[1]).(func(RunLoopTimer))
+			fn(types.Pointer(args[0]))
+			return 0
+		}},
+	})
+}
+
+// AddTimer adds the timer to a run loop mode. The run loop retains the timer.
+// A timer can be added to a single run loop at a time, but to multiple modes
+// of it. If mode is RunLoopCommonModes, the timer is added to all common
+// modes.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopaddtimer(_:_:_:)
+func AddTimer(r RunLoop, t RunLoopTimer, mode RunLoopMode) {
+	cfRunLoopAddTimer(r, t, mode)
+}
+
+// RemoveTimer removes the timer from a run loop mode and releases it.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopremovetimer(_:_:_:)
+func RemoveTimer(r RunLoop, t RunLoopTimer, mode RunLoopMode) {
+	cfRunLoopRemoveTimer(r, t, mode)
+}
+
+// ContainsTimer reports whether the run loop mode contains the timer.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopcontainstimer(_:_:_:)
+func ContainsTimer(r RunLoop, t RunLoopTimer, mode RunLoopMode) bool {
+	return cfRunLoopContainsTimer(r, t, mode)
+}
+
+// InvalidateRunLoopTimer invalidates the timer and removes it from all run
+// loop modes. An invalidated timer never fires again.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimerinvalidate(_:)
+func InvalidateRunLoopTimer(t RunLoopTimer) {
+	cfRunLoopTimerInvalidate(t)
+}
+
+// IsRunLoopTimerValid reports whether the timer is valid, i.e. it was not
+// invalidated.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimerisvalid(_:)
+func IsRunLoopTimerValid(t RunLoopTimer) bool {
+	return cfRunLoopTimerIsValid(t)
+}
+
+// RunLoopTimerNextFireDate returns the next time the timer fires.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimergetnextfiredate(_:)
+func RunLoopTimerNextFireDate(t RunLoopTimer) time.Time {
+	return AbsoluteTime(cfRunLoopTimerGetNextFireDate(t)).Time()
+}
+
+// SetRunLoopTimerNextFireDate sets the next time the timer fires. It does not
+// change the interval of a repeating timer, so subsequent firings are
+// relative to the new date. Setting a date in the far future effectively
+// pauses the timer, e.g. to implement idle timeouts.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimersetnextfiredate(_:_:)
+func SetRunLoopTimerNextFireDate(t RunLoopTimer, fireDate time.Time) {
+	cfRunLoopTimerSetNextFireDate(t, float64(AbsoluteTimeOf(fireDate)))
+}
+
+// RunLoopTimerInterval returns the interval of a repeating timer, or zero if
+// the timer does not repeat.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimergetinterval(_:)
+func RunLoopTimerInterval(t RunLoopTimer) time.Duration {
+	return secondsDuration(cfRunLoopTimerGetInterval(t))
+}
+
+// RunLoopTimerDoesRepeat reports whether the timer repeats.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimerdoesrepeat(_:)
+func RunLoopTimerDoesRepeat(t RunLoopTimer) bool {
+	return cfRunLoopTimerDoesRepeat(t)
+}
+
+// RunLoopTimerTolerance returns the amount of time after the fire date that
+// the timer may fire.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimergettolerance(_:)
+func RunLoopTimerTolerance(t RunLoopTimer) time.Duration {
+	return secondsDuration(cfRunLoopTimerGetTolerance(t))
+}
+
+// SetRunLoopTimerTolerance sets the amount of time after the fire date that
+// the timer may fire, allowing the system to coalesce timers for power
+// savings. The system may cap the tolerance to a fraction of the interval.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfrunlooptimersettolerance(_:_:)
+func SetRunLoopTimerTolerance(t RunLoopTimer, tolerance time.Duration) {
+	cfRunLoopTimerSetTolerance(t, tolerance.Seconds())
+}
+
+// secondsDuration returns the time interval in seconds as time.Duration.
+func secondsDuration(sec float64) time.Duration {
+	return time.Duration(sec * float64(time.Second))
+}
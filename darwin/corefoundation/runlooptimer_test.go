@@ -0,0 +1,100 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+)
+
+func TestRunLoopTimer(t *testing.T) {
+	l := startRunLoop(t)
+	defer l.stop()
+	mode := corefoundation.RunLoopDefaultMode()
+
+	fired := make(chan int, 10)
+	n := 0
+	timer, ok := corefoundation.CreateRunLoopTimer(corefoundation.AllocatorDefault(), time.Now(), 10*time.Millisecond, func(timer corefoundation.RunLoopTimer) {
+		n++
+		fired <- n
+		if n == 3 {
+			corefoundation.InvalidateRunLoopTimer(timer)
+		}
+	})
+	if !ok {
+		t.Fatal("failed to create timer")
+	}
+	defer corefoundation.Release(timer)
+	if !corefoundation.RunLoopTimerDoesRepeat(timer) {
+		t.Error("expected the timer to repeat")
+	}
+	if d := corefoundation.RunLoopTimerInterval(timer); d != 10*time.Millisecond {
+		t.Errorf("expected interval 10ms, got %v", d)
+	}
+	corefoundation.SetRunLoopTimerTolerance(timer, time.Millisecond)
+	if d := corefoundation.RunLoopTimerTolerance(timer); d != time.Millisecond {
+		t.Errorf("expected tolerance 1ms, got %v", d)
+	}
+
+	corefoundation.AddTimer(l.r, timer, mode)
+	if !corefoundation.ContainsTimer(l.r, timer, mode) {
+		t.Error("expected the run loop to contain the timer")
+	}
+	for i := 1; i <= 3; i++ {
+		select {
+		case got := <-fired:
+			if got != i {
+				t.Fatalf("expected firing %d, got %d", i, got)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for the timer")
+		}
+	}
+	// Wait for the run loop to finish the callout.
+	corefoundation.PerformAndWait(l.r, mode, func() {})
+	if corefoundation.IsRunLoopTimerValid(timer) || corefoundation.ContainsTimer(l.r, timer, mode) {
+		t.Error("expected the timer to be invalidated and removed")
+	}
+}
+
+func TestRunLoopTimerOneShot(t *testing.T) {
+	l := startRunLoop(t)
+	defer l.stop()
+	mode := corefoundation.RunLoopDefaultMode()
+
+	fired := make(chan struct{}, 1)
+	timer, ok := corefoundation.CreateRunLoopTimer(corefoundation.AllocatorDefault(), time.Now().Add(time.Hour), 0, func(corefoundation.RunLoopTimer) {
+		fired <- struct{}{}
+	})
+	if !ok {
+		t.Fatal("failed to create timer")
+	}
+	defer corefoundation.Release(timer)
+	if corefoundation.RunLoopTimerDoesRepeat(timer) {
+		t.Error("expected a one-shot timer")
+	}
+	corefoundation.AddTimer(l.r, timer, mode)
+	select {
+	case <-fired:
+		t.Fatal("timer fired before its fire date")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	date := time.Now().Add(10 * time.Millisecond)
+	corefoundation.SetRunLoopTimerNextFireDate(timer, date)
+	if got := corefoundation.RunLoopTimerNextFireDate(timer); got.Sub(date) > time.Microsecond || date.Sub(got) > time.Microsecond {
+		t.Errorf("expected next fire date %v, got %v", date, got)
+	}
+	select {
+	case <-fired:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the timer")
+	}
+	corefoundation.PerformAndWait(l.r, mode, func() {})
+	if corefoundation.IsRunLoopTimerValid(timer) {
+		t.Error("expected a one-shot timer to be invalidated after firing")
+	}
+}
@@ -0,0 +1,151 @@
+//go:build darwin
+// +build darwin
+
+package corefoundation
+
+// This file provides CFReadStream and CFWriteStream APIs for in-memory
+// streams.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfreadstream
+//  • https://developer.apple.com/documentation/corefoundation/cfwritestream
+
+import (
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/types"
+)
+
+// ReadStream is an opaque reference to a CFReadStream type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfreadstream
+type ReadStream types.CFReadStream
+
+// WriteStream is an opaque reference to a CFWriteStream type.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfwritestream
+type WriteStream types.CFWriteStream
+
+// CreateReadStreamWithBytes creates a readable stream for a copy of the given
+// bytes. The copy is allocated with alloc and freed when the stream is
+// deallocated. The stream must be opened with OpenReadStream before use.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfreadstreamcreatewithbytesnocopy(_:_:_:_:)
+func CreateReadStreamWithBytes(alloc Allocator, b []byte) (ReadStream, bool) {
+	if len(b) == 0 {
+		out := cfReadStreamCreateWithBytesNoCopy(alloc, nil, 0, AllocatorNone())
+		return out, out != 0
+	}
+	p := cfAllocatorAllocate(alloc, len(b), 0)
+	if p == 0 {
+		return nil, false
+	}
+	buf := unsafe.Slice(*(**byte)(unsafe.Pointer(&p)), len(b))
+	copy(buf, b)
+	out := cfReadStreamCreateWithBytesNoCopy(alloc, buf, len(buf), alloc)
+	if out == 0 {
+		cfAllocatorDeallocate(alloc, p)
+	}
+	return out, out != 0
+}
+
+// OpenReadStream opens a stream for reading. It reports whether the stream
+// was opened successfully.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfreadstreamopen(_:)
+func OpenReadStream(r ReadStream) bool {
+	return cfReadStreamOpen(r)
+}
+
+// CloseReadStream closes a readable stream.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfreadstreamclose(_:)
+func CloseReadStream(r ReadStream) {
+	cfReadStreamClose(r)
+}
+
+// ReadStreamError returns the error that caused the readable stream to fail,
+// or nil if there is no error.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfreadstreamcopyerror(_:)
+func ReadStreamError(r ReadStream) error {
+	e := cfReadStreamCopyError(r)
+	if e == 0 {
+		return nil
+	}
+	defer Release(e)
+	return GoError(e)
+}
+
+// CreateMemoryWriteStream creates a writable stream that writes to memory
+// buffers allocated with alloc. The stream must be opened with OpenWriteStream
+// before use, and CopyWriteStreamData returns the data written to it.
+//
+// If there was a problem creating the object, it returns false.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfwritestreamcreatewithallocatedbuffers(_:_:)
+func CreateMemoryWriteStream(alloc Allocator) (WriteStream, bool) {
+	out := cfWriteStreamCreateWithAllocatedBuffers(alloc, alloc)
+	return out, out != 0
+}
+
+// OpenWriteStream opens a stream for writing. It reports whether the stream
+// was opened successfully.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfwritestreamopen(_:)
+func OpenWriteStream(w WriteStream) bool {
+	return cfWriteStreamOpen(w)
+}
+
+// CloseWriteStream closes a writable stream.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfwritestreamclose(_:)
+func CloseWriteStream(w WriteStream) {
+	cfWriteStreamClose(w)
+}
+
+// CopyWriteStreamData returns the data written to a stream created with
+// CreateMemoryWriteStream. The caller owns the returned reference.
+//
+// It returns false if the stream is not a memory stream or has not been
+// opened.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfwritestreamcopyproperty(_:_:)
+//  • https://developer.apple.com/documentation/corefoundation/kcfstreampropertydatawritten
+func CopyWriteStreamData(w WriteStream) (Data, bool) {
+	out := cfWriteStreamCopyProperty(w, streamPropertyDataWritten())
+	if out == 0 {
+		return nil, false
+	}
+	d, ok := AsData(out)
+	if !ok {
+		Release(out)
+	}
+	return d, ok
+}
+
+// WriteStreamError returns the error that caused the writable stream to fail,
+// or nil if there is no error.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfwritestreamcopyerror(_:)
+func WriteStreamError(w WriteStream) error {
+	e := cfWriteStreamCopyError(w)
+	if e == 0 {
+		return nil
+	}
+	defer Release(e)
+	return GoError(e)
+}
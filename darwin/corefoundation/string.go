@@ -9,11 +9,13 @@ package corefoundation
 //  • https://developer.apple.com/documentation/corefoundation/cfstring-rfh
 
 import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
 	"unsafe"
 
 	_ "go4.org/unsafe/assume-no-moving-gc"
 
-	"github.com/noncgo/x/darwin/internal/cabi"
 	"github.com/noncgo/x/darwin/internal/cstr"
 	"github.com/noncgo/x/darwin/internal/types"
 )
@@ -76,7 +78,7 @@ func UnsafeStr(s string) unsafe.Pointer {
 		length uint32
 	}
 	return unsafe.Pointer(&constString{
-		isa:    extern___CFConstantStringClassReference_getAddr(),
+		isa:    constantStringClass(),
 		info:   [4]byte{0xC8, 0x07},
 		ptr:    ptr,
 		length: uint32(length),
@@ -91,15 +93,8 @@ func UnsafeStr(s string) unsafe.Pointer {
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1541864-cfstringcreatearraybyseparatings
 func CreateArrayBySeparatingStrings(alloc Allocator, s, sep String) (Array, bool) {
-	var out uintptr
-	cabi.Call(
-		extern_CFStringCreateArrayBySeparatingStrings_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(alloc.Pointer()),
-		cabi.Uintptr(s.Pointer()),
-		cabi.Uintptr(sep.Pointer()),
-	)
-	return types.Pointer(out), out != 0
+	out := cfStringCreateArrayBySeparatingStrings(alloc, s, sep)
+	return out, out != 0
 }
 
 // CreateStringExternalRepresentation returns a Data object that stores the
@@ -112,16 +107,8 @@ func CreateArrayBySeparatingStrings(alloc Allocator, s, sep String) (Array, bool
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1543169-cfstringcreateexternalrepresenta
 func CreateStringExternalRepresentation(alloc Allocator, s String, enc StringEncoding, lossByte byte) (Data, bool) {
-	var out uintptr
-	cabi.Call(
-		extern_CFStringCreateExternalRepresentation_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(alloc.Pointer()),
-		cabi.Uintptr(s.Pointer()),
-		cabi.Uint32(uint32(enc)),
-		cabi.Uint8(lossByte),
-	)
-	return types.Pointer(out), out != 0
+	out := cfStringCreateExternalRepresentation(alloc, s, uint32(enc), lossByte)
+	return out, out != 0
 }
 
 // CreateStringWithBytes creates a string from a buffer containing characters in
@@ -137,15 +124,176 @@ func CreateStringExternalRepresentation(alloc Allocator, s String, enc StringEnc
 // References
 //  • https://developer.apple.com/documentation/corefoundation/1543419-cfstringcreatewithbytes
 func CreateStringWithBytes(alloc Allocator, data []byte, enc StringEncoding, ext bool) (String, bool) {
-	var out uintptr
-	cabi.Call(
-		extern_CFStringCreateWithBytes_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(alloc.Pointer()),
-		cabi.Bytes(data),
-		cabi.Int(len(data)),
-		cabi.Uint32(uint32(enc)),
-		cabi.Bool(ext),
-	)
-	return types.Pointer(out), out != 0
+	out := cfStringCreateWithBytes(alloc, data, len(data), uint32(enc), ext)
+	return out, out != 0
+}
+
+// stringBufferSize is the size of the buffer that GoString uses for short
+// strings before falling back to a heap-allocated one.
+const stringBufferSize = 256
+
+// NewString creates a String with the contents of s. Invalid UTF-8 sequences
+// in s are replaced with U+FFFD replacement character.
+//
+// Unlike CreateStringWithBytes, it does not copy s before passing it to Core
+// Foundation. It returns nil if there was a problem creating the object. The
+// caller owns the returned reference.
+func NewString(s string) String {
+	if !utf8.ValidString(s) {
+		s = strings.ToValidUTF8(s, string(utf8.RuneError))
+	}
+	// CFStringCreateWithBytes copies the bytes and does not modify them.
+	ptr, length := cstr.Unpack(&s)
+	b := unsafe.Slice((*byte)(ptr), length)
+	out := cfStringCreateWithBytes(AllocatorDefault(), b, len(b), uint32(StringEncodingUTF8), false)
+	if out == 0 {
+		return nil
+	}
+	return out
+}
+
+// GoString returns the contents of the String as a Go string. Unpaired UTF-16
+// surrogates, that cannot be represented in UTF-8, are replaced with U+FFFD
+// replacement character.
+//
+// It uses the internal representation of ASCII strings if available, converts
+// short strings using a fixed-size buffer and falls back to converting the
+// string in chunks otherwise.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfstringgetcstringptr(_:_:)
+//  • https://developer.apple.com/documentation/corefoundation/cfstringgetcstring(_:_:_:_:)
+//  • https://developer.apple.com/documentation/corefoundation/cfstringgetbytes(_:_:_:_:_:_:_:_:)
+func GoString(s String) string {
+	gs, _ := goString(s, true)
+	return gs
+}
+
+// goString returns the contents of the String as a Go string. If lossy is
+// false, it returns false if the string contains unpaired surrogates, and
+// replaces them with U+FFFD replacement character otherwise.
+func goString(s String, lossy bool) (string, bool) {
+	n := GetStringLength(s)
+	if n == 0 {
+		return "", true
+	}
+	if p := cfStringGetCStringPtr(s, uint32(StringEncodingUTF8)); p != 0 {
+		// The pointer is only available for strings with single-byte
+		// characters, so the string has exactly n bytes, including
+		// embedded null characters.
+		return cstr.GoStringN(p, n), true
+	}
+	// A UTF-16 code unit takes at most 3 bytes in UTF-8, so the conversion
+	// fails only for unpaired surrogates.
+	if 3*n < stringBufferSize {
+		if gs, ok := goStringBuffer(s); ok {
+			return gs, true
+		}
+	}
+	return goStringBytes(s, n, lossy)
+}
+
+// goStringBuffer converts the string using CFStringGetCString. It returns false
+// if the string does not fit in the buffer or cannot be converted to UTF-8.
+func goStringBuffer(s String) (string, bool) {
+	var buf [stringBufferSize]byte
+	// UTF-8 does not use 0xFF byte, so the null terminator is the byte
+	// before the first unused one, even if the string contains embedded
+	// null characters.
+	for i := range buf {
+		buf[i] = 0xFF
+	}
+	if !cfStringGetCString(s, &buf[0], len(buf), uint32(StringEncodingUTF8)) {
+		return "", false
+	}
+	n := bytes.IndexByte(buf[:], 0xFF)
+	if n < 0 {
+		n = len(buf)
+	}
+	return string(buf[:n-1]), true
+}
+
+// goStringBytes converts the string of n UTF-16 code units using
+// CFStringGetBytes. See goString for the meaning of lossy.
+func goStringBytes(s String, n int, lossy bool) (string, bool) {
+	// Each code unit takes at least a byte in UTF-8.
+	b := make([]byte, 0, n+utf8.UTFMax)
+	for loc := 0; loc < n; {
+		if cap(b)-len(b) < utf8.UTFMax {
+			grown := make([]byte, len(b), 2*cap(b))
+			copy(grown, b)
+			b = grown
+		}
+		free := b[len(b):cap(b)]
+		var used int
+		converted := cfStringGetBytes(
+			s,
+			loc,
+			n-loc,
+			uint32(StringEncodingUTF8),
+			0,
+			false,
+			free,
+			len(free),
+			unsafe.Pointer(&used),
+		)
+		if converted == 0 {
+			// There is enough space for any character, so the
+			// character at loc is an unpaired surrogate.
+			if !lossy {
+				return "", false
+			}
+			b = append(b, string(utf8.RuneError)...)
+			loc++
+			continue
+		}
+		b = b[:len(b)+used]
+		loc += converted
+	}
+	return string(b), true
+}
+
+// GetStringLength returns the number of UTF-16 code units in a string.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfstringgetlength(_:)
+func GetStringLength(s String) int {
+	return cfStringGetLength(s)
+}
+
+// CompareStrings compares two strings with the given options. It returns -1 if
+// a is less than b, 0 if they are equal and +1 if a is greater than b.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfstringcompare(_:_:_:)
+func CompareStrings(a, b String, opts StringCompareFlags) int {
+	return cfStringCompare(a, b, uint(opts))
+}
+
+// FindString returns the range of the first occurrence of sub in s, or the last
+// one if opts include CompareBackwards. The range is in UTF-16 code units. It
+// returns false if s does not contain sub.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfstringfindwithoptions(_:_:_:_:_:)
+func FindString(s, sub String, opts StringCompareFlags) (Range, bool) {
+	var r Range
+	ok := cfStringFindWithOptions(s, sub, 0, GetStringLength(s), uint(opts), unsafe.Pointer(&r))
+	return r, ok
+}
+
+// StringHasPrefix reports whether s begins with prefix.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfstringhasprefix(_:_:)
+func StringHasPrefix(s, prefix String) bool {
+	return cfStringHasPrefix(s, prefix)
+}
+
+// StringHasSuffix reports whether s ends with suffix.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/cfstringhassuffix(_:_:)
+func StringHasSuffix(s, suffix String) bool {
+	return cfStringHasSuffix(s, suffix)
 }
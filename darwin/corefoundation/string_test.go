@@ -5,7 +5,9 @@ package corefoundation_test
 
 import (
 	"runtime"
+	"strings"
 	"testing"
+	"unicode/utf16"
 
 	_ "go4.org/unsafe/assume-no-moving-gc"
 
@@ -53,3 +55,130 @@ func TestUnsafeStrExternalRepresentation(t *testing.T) {
 		t.Fatalf("external representation differs from constant string (expected %q, got %q)", cs, gs)
 	}
 }
+
+func TestGoString(t *testing.T) {
+	testCases := []struct {
+		name string
+		s    string
+	}{
+		{"Empty", ""},
+		{"ASCII", "example"},
+		{"ASCIINull", "a\x00b"},
+		{"Short", "héllo, 世界 🌍"},
+		{"ShortNull", "é\x00"},
+		{"Long", strings.Repeat("héllo, 世界 🌍 ", 100)},
+		{"LongASCII", strings.Repeat("example ", 100)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := corefoundation.NewString(tc.s)
+			if s == nil {
+				t.Fatal("failed to create a string")
+			}
+			defer corefoundation.Release(s)
+
+			if n := corefoundation.GetStringLength(s); n != len(utf16.Encode([]rune(tc.s))) {
+				t.Errorf("unexpected length %d", n)
+			}
+			if gs := corefoundation.GoString(s); gs != tc.s {
+				t.Errorf("expected %q, got %q", tc.s, gs)
+			}
+		})
+	}
+}
+
+func TestNewStringInvalidUTF8(t *testing.T) {
+	s := corefoundation.NewString("a\xffb")
+	if s == nil {
+		t.Fatal("failed to create a string")
+	}
+	defer corefoundation.Release(s)
+	if gs := corefoundation.GoString(s); gs != "a�b" {
+		t.Errorf("expected invalid byte to be replaced, got %q", gs)
+	}
+}
+
+func TestCompareStrings(t *testing.T) {
+	a, b := corefoundation.NewString("Name7"), corefoundation.NewString("name25")
+	defer corefoundation.Release(a)
+	defer corefoundation.Release(b)
+
+	if c := corefoundation.CompareStrings(a, b, 0); c != -1 {
+		t.Errorf("expected -1 for literal comparison, got %d", c)
+	}
+	if c := corefoundation.CompareStrings(b, a, corefoundation.CompareCaseInsensitive); c != -1 {
+		t.Errorf("expected -1 for case-insensitive comparison, got %d", c)
+	}
+	opts := corefoundation.CompareCaseInsensitive | corefoundation.CompareNumerically
+	if c := corefoundation.CompareStrings(a, b, opts); c != -1 {
+		t.Errorf("expected -1 for numeric comparison, got %d", c)
+	}
+	if c := corefoundation.CompareStrings(a, a, 0); c != 0 {
+		t.Errorf("expected 0 for equal strings, got %d", c)
+	}
+}
+
+func TestFindString(t *testing.T) {
+	s := corefoundation.NewString("🌍 abc abc")
+	sub := corefoundation.NewString("ABC")
+	defer corefoundation.Release(s)
+	defer corefoundation.Release(sub)
+
+	if _, ok := corefoundation.FindString(s, sub, 0); ok {
+		t.Error("literal search should not find a match")
+	}
+	r, ok := corefoundation.FindString(s, sub, corefoundation.CompareCaseInsensitive)
+	if want := (corefoundation.Range{Location: 3, Length: 3}); !ok || r != want {
+		t.Errorf("expected %v, got %v (found %v)", want, r, ok)
+	}
+	r, ok = corefoundation.FindString(s, sub, corefoundation.CompareCaseInsensitive|corefoundation.CompareBackwards)
+	if want := (corefoundation.Range{Location: 7, Length: 3}); !ok || r != want {
+		t.Errorf("expected %v, got %v (found %v)", want, r, ok)
+	}
+
+	prefix, suffix := corefoundation.NewString("🌍"), corefoundation.NewString("bc")
+	defer corefoundation.Release(prefix)
+	defer corefoundation.Release(suffix)
+	if !corefoundation.StringHasPrefix(s, prefix) || corefoundation.StringHasPrefix(s, suffix) {
+		t.Error("unexpected StringHasPrefix result")
+	}
+	if !corefoundation.StringHasSuffix(s, suffix) || corefoundation.StringHasSuffix(s, prefix) {
+		t.Error("unexpected StringHasSuffix result")
+	}
+}
+
+func BenchmarkGoString(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		s    string
+	}{
+		// ASCII strings use the internal representation.
+		{"CStringPtr", strings.Repeat("a", 64)},
+		// Short non-ASCII strings fit in the fixed-size buffer.
+		{"CString", strings.Repeat("é", 64)},
+		// Long strings are converted in chunks.
+		{"Bytes", strings.Repeat("é", 4096)},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			s := corefoundation.NewString(bm.s)
+			if s == nil {
+				b.Fatal("failed to create a string")
+			}
+			defer corefoundation.Release(s)
+			b.SetBytes(int64(len(bm.s)))
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_ = corefoundation.GoString(s)
+			}
+		})
+	}
+}
+
+func BenchmarkNewString(b *testing.B) {
+	s := strings.Repeat("é", 64)
+	b.SetBytes(int64(len(s)))
+	for i := 0; i < b.N; i++ {
+		corefoundation.Release(corefoundation.NewString(s))
+	}
+}
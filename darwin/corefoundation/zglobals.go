@@ -7,8 +7,10 @@ package corefoundation
 
 import (
 	"sync"
+	"unsafe"
 
 	"github.com/noncgo/x/darwin/internal/dyld"
+	"github.com/noncgo/x/darwin/internal/types"
 )
 
 // Uh, apparently cgo:cgo_import_dynamic links against function call stub?
@@ -35,19 +37,214 @@ var globals struct {
 	kCFAllocatorUseContext_addr uintptr
 	kCFAllocatorUseContext_once sync.Once
 
+	kCFBooleanFalse_addr uintptr
+	kCFBooleanFalse_once sync.Once
+
+	kCFBooleanTrue_addr uintptr
+	kCFBooleanTrue_once sync.Once
+
+	kCFNull_addr uintptr
+	kCFNull_once sync.Once
+
 	kCFRunLoopCommonModes_addr uintptr
 	kCFRunLoopCommonModes_once sync.Once
 
 	kCFRunLoopDefaultMode_addr uintptr
 	kCFRunLoopDefaultMode_once sync.Once
 
+	kCFErrorLocalizedDescriptionKey_addr uintptr
+	kCFErrorLocalizedDescriptionKey_once sync.Once
+
+	kCFErrorUnderlyingErrorKey_addr uintptr
+	kCFErrorUnderlyingErrorKey_once sync.Once
+
+	kCFStreamPropertyDataWritten_addr uintptr
+	kCFStreamPropertyDataWritten_once sync.Once
+
 	kCFTypeArrayCallBacks_addr uintptr
 	kCFTypeArrayCallBacks_once sync.Once
 
+	kCFTypeDictionaryKeyCallBacks_addr uintptr
+	kCFTypeDictionaryKeyCallBacks_once sync.Once
+
+	kCFTypeDictionaryValueCallBacks_addr uintptr
+	kCFTypeDictionaryValueCallBacks_once sync.Once
+
 	__CFConstantStringClassReference_addr uintptr
 	__CFConstantStringClassReference_once sync.Once
 }
 
+// AllocatorDefault returns an allocator that is synonym for NULL.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfallocatordefault
+func AllocatorDefault() Allocator {
+	addr := extern_kCFAllocatorDefault_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// AllocatorMalloc returns an allocator that uses malloc, realloc and free.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfallocatormalloc
+func AllocatorMalloc() Allocator {
+	addr := extern_kCFAllocatorMalloc_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// AllocatorMallocZone returns an allocator that explicitly uses the default
+// malloc zone, returned by malloc_default_zone.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfallocatormalloczone
+func AllocatorMallocZone() Allocator {
+	addr := extern_kCFAllocatorMallocZone_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// AllocatorNone returns an allocator does not nothing.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfallocatornull
+func AllocatorNone() Allocator {
+	addr := extern_kCFAllocatorNull_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// AllocatorSystem returns default system allocator.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfallocatorsystemdefault
+func AllocatorSystem() Allocator {
+	addr := extern_kCFAllocatorSystemDefault_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// AllocatorUseContext returns a special allocator argument to CreateAllocator
+// that uses the functions given in the context to allocate the allocator.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfallocatorusecontext
+func AllocatorUseContext() Allocator {
+	addr := extern_kCFAllocatorUseContext_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// BooleanFalse returns the Boolean false value.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfbooleanfalse
+func BooleanFalse() Boolean {
+	addr := extern_kCFBooleanFalse_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// BooleanTrue returns the Boolean true value.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfbooleantrue
+func BooleanTrue() Boolean {
+	addr := extern_kCFBooleanTrue_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// Null returns the singleton NullObject value that represents null values in
+// collection objects, which do not allow NULL.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfnull
+func Null() NullObject {
+	addr := extern_kCFNull_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// RunLoopCommonModes returns a special pseudo-mode that allows
+// associating more than one mode with a given run loop source.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfrunloopcommonmodes
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopmode
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloop-rht
+func RunLoopCommonModes() RunLoopMode {
+	addr := extern_kCFRunLoopCommonModes_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// RunLoopDefaultMode returns the default mode of the run loop.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcfrunloopdefaultmode
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloopmode
+//  • https://developer.apple.com/documentation/corefoundation/cfrunloop-rht
+func RunLoopDefaultMode() RunLoopMode {
+	addr := extern_kCFRunLoopDefaultMode_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// ErrorLocalizedDescriptionKey returns the key of the localized description
+// in the userInfo dictionary of errors.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcferrorlocalizeddescriptionkey
+func ErrorLocalizedDescriptionKey() String {
+	addr := extern_kCFErrorLocalizedDescriptionKey_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// ErrorUnderlyingErrorKey returns the key of the underlying error in the
+// userInfo dictionary of errors.
+//
+// References
+//  • https://developer.apple.com/documentation/corefoundation/kcferrorunderlyingerrorkey
+func ErrorUnderlyingErrorKey() String {
+	addr := extern_kCFErrorUnderlyingErrorKey_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// streamPropertyDataWritten returns the value of kCFStreamPropertyDataWritten global constant.
+func streamPropertyDataWritten() String {
+	addr := extern_kCFStreamPropertyDataWritten_getAddr()
+	addr = **(**uintptr)(unsafe.Pointer(&addr))
+	return types.Pointer(addr)
+}
+
+// typeArrayCallbacks returns the address of kCFTypeArrayCallBacks data symbol.
+func typeArrayCallbacks() unsafe.Pointer {
+	addr := extern_kCFTypeArrayCallBacks_getAddr()
+	return *(*unsafe.Pointer)(unsafe.Pointer(&addr))
+}
+
+// typeDictionaryKeyCallbacks returns the address of kCFTypeDictionaryKeyCallBacks data symbol.
+func typeDictionaryKeyCallbacks() unsafe.Pointer {
+	addr := extern_kCFTypeDictionaryKeyCallBacks_getAddr()
+	return *(*unsafe.Pointer)(unsafe.Pointer(&addr))
+}
+
+// typeDictionaryValueCallbacks returns the address of kCFTypeDictionaryValueCallBacks data symbol.
+func typeDictionaryValueCallbacks() unsafe.Pointer {
+	addr := extern_kCFTypeDictionaryValueCallBacks_getAddr()
+	return *(*unsafe.Pointer)(unsafe.Pointer(&addr))
+}
+
+// constantStringClass returns the address of __CFConstantStringClassReference data symbol.
+func constantStringClass() uintptr {
+	addr := extern___CFConstantStringClassReference_getAddr()
+	return *(*uintptr)(unsafe.Pointer(&addr))
+}
+
 func extern_kCFAllocatorDefault_getAddr() uintptr {
 	globals.kCFAllocatorDefault_once.Do(func() {
 		sym, err := dyld.Lookup("kCFAllocatorDefault")
@@ -114,6 +311,39 @@ func extern_kCFAllocatorUseContext_getAddr() uintptr {
 	return globals.kCFAllocatorUseContext_addr
 }
 
+func extern_kCFBooleanFalse_getAddr() uintptr {
+	globals.kCFBooleanFalse_once.Do(func() {
+		sym, err := dyld.Lookup("kCFBooleanFalse")
+		if err != nil {
+			panic(err)
+		}
+		globals.kCFBooleanFalse_addr = sym.Addr
+	})
+	return globals.kCFBooleanFalse_addr
+}
+
+func extern_kCFBooleanTrue_getAddr() uintptr {
+	globals.kCFBooleanTrue_once.Do(func() {
+		sym, err := dyld.Lookup("kCFBooleanTrue")
+		if err != nil {
+			panic(err)
+		}
+		globals.kCFBooleanTrue_addr = sym.Addr
+	})
+	return globals.kCFBooleanTrue_addr
+}
+
+func extern_kCFNull_getAddr() uintptr {
+	globals.kCFNull_once.Do(func() {
+		sym, err := dyld.Lookup("kCFNull")
+		if err != nil {
+			panic(err)
+		}
+		globals.kCFNull_addr = sym.Addr
+	})
+	return globals.kCFNull_addr
+}
+
 func extern_kCFRunLoopCommonModes_getAddr() uintptr {
 	globals.kCFRunLoopCommonModes_once.Do(func() {
 		sym, err := dyld.Lookup("kCFRunLoopCommonModes")
@@ -136,6 +366,39 @@ func extern_kCFRunLoopDefaultMode_getAddr() uintptr {
 	return globals.kCFRunLoopDefaultMode_addr
 }
 
+func extern_kCFErrorLocalizedDescriptionKey_getAddr() uintptr {
+	globals.kCFErrorLocalizedDescriptionKey_once.Do(func() {
+		sym, err := dyld.Lookup("kCFErrorLocalizedDescriptionKey")
+		if err != nil {
+			panic(err)
+		}
+		globals.kCFErrorLocalizedDescriptionKey_addr = sym.Addr
+	})
+	return globals.kCFErrorLocalizedDescriptionKey_addr
+}
+
+func extern_kCFErrorUnderlyingErrorKey_getAddr() uintptr {
+	globals.kCFErrorUnderlyingErrorKey_once.Do(func() {
+		sym, err := dyld.Lookup("kCFErrorUnderlyingErrorKey")
+		if err != nil {
+			panic(err)
+		}
+		globals.kCFErrorUnderlyingErrorKey_addr = sym.Addr
+	})
+	return globals.kCFErrorUnderlyingErrorKey_addr
+}
+
+func extern_kCFStreamPropertyDataWritten_getAddr() uintptr {
+	globals.kCFStreamPropertyDataWritten_once.Do(func() {
+		sym, err := dyld.Lookup("kCFStreamPropertyDataWritten")
+		if err != nil {
+			panic(err)
+		}
+		globals.kCFStreamPropertyDataWritten_addr = sym.Addr
+	})
+	return globals.kCFStreamPropertyDataWritten_addr
+}
+
 func extern_kCFTypeArrayCallBacks_getAddr() uintptr {
 	globals.kCFTypeArrayCallBacks_once.Do(func() {
 		sym, err := dyld.Lookup("kCFTypeArrayCallBacks")
@@ -147,6 +410,28 @@ func extern_kCFTypeArrayCallBacks_getAddr() uintptr {
 	return globals.kCFTypeArrayCallBacks_addr
 }
 
+func extern_kCFTypeDictionaryKeyCallBacks_getAddr() uintptr {
+	globals.kCFTypeDictionaryKeyCallBacks_once.Do(func() {
+		sym, err := dyld.Lookup("kCFTypeDictionaryKeyCallBacks")
+		if err != nil {
+			panic(err)
+		}
+		globals.kCFTypeDictionaryKeyCallBacks_addr = sym.Addr
+	})
+	return globals.kCFTypeDictionaryKeyCallBacks_addr
+}
+
+func extern_kCFTypeDictionaryValueCallBacks_getAddr() uintptr {
+	globals.kCFTypeDictionaryValueCallBacks_once.Do(func() {
+		sym, err := dyld.Lookup("kCFTypeDictionaryValueCallBacks")
+		if err != nil {
+			panic(err)
+		}
+		globals.kCFTypeDictionaryValueCallBacks_addr = sym.Addr
+	})
+	return globals.kCFTypeDictionaryValueCallBacks_addr
+}
+
 func extern___CFConstantStringClassReference_getAddr() uintptr {
 	globals.__CFConstantStringClassReference_once.Do(func() {
 		sym, err := dyld.Lookup("__CFConstantStringClassReference")
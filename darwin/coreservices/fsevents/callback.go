@@ -33,6 +33,9 @@ type callbackInfo struct {
 	Flags CreateFlags
 }
 
+// maxPathLen is the maximum length of event paths (PATH_MAX on Darwin).
+const maxPathLen = 1024
+
 // callbackArgs carries arguments from the C callback to Callback function.
 //
 // It is allocated on the system stack in crosscallCallback.
@@ -77,8 +80,9 @@ func callback(f *callbackArgs) {
 			// TODO: f.eventPaths is CFArrayRef of CFStringRef
 			corefoundation.Show(types.Pointer(f.eventPaths))
 		default:
-			s := cstr.GoString(f.eventPaths + i*unsafe.Sizeof(sizeofEventPath))
-			events[i].Path = s
+			eventPath := f.eventPaths + i*sizeofEventPath
+			p := **(**uintptr)(unsafe.Pointer(&eventPath))
+			events[i].Path = cstr.GoStringMax(p, maxPathLen)
 		}
 
 		eventFlag := f.eventFlags + i*sizeofEventFlags
@@ -10,7 +10,6 @@ import (
 	_ "go4.org/unsafe/assume-no-moving-gc"
 
 	"github.com/noncgo/x/darwin/corefoundation"
-	"github.com/noncgo/x/darwin/internal/cabi"
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -67,17 +66,14 @@ func CreateStream(
 	}
 	ctxt := &streamContext{Info: info}
 
-	var out uintptr
-	cabi.Call(
-		extern_FSEventStreamCreate_trampolineABI0,
-		cabi.OutUintptr(&out),
-		cabi.Uintptr(alloc.Pointer()),
-		cabi.Uintptr(crosscallCallbackABI0),
-		cabi.UnsafePointer(unsafe.Pointer(ctxt)),
-		cabi.Uintptr(paths.Pointer()),
-		cabi.Uint64(uint64(sinceWhen)),
-		cabi.Float64(latency.Seconds()),
-		cabi.Uint32(uint32(flags)),
+	out := fsEventStreamCreate(
+		alloc,
+		crosscallCallbackABI0,
+		unsafe.Pointer(ctxt),
+		paths,
+		uint64(sinceWhen),
+		latency.Seconds(),
+		uint32(flags),
 	)
-	return newStream(out, info), out != 0
+	return newStream(uintptr(out), info), out != 0
 }
@@ -0,0 +1,113 @@
+package fsevents_test
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/noncgo/x/darwin/coreservices/fsevents"
+)
+
+func TestCreateFlagsString(t *testing.T) {
+	testCases := []struct {
+		flags fsevents.CreateFlags
+		str   string
+	}{
+		{fsevents.CreateFlagNone, "None"},
+		{fsevents.CreateFlagUseCFTypes, "UseCFTypes"},
+		{fsevents.CreateFlagUseCFTypes | fsevents.CreateFlagFileEvents, "UseCFTypes|FileEvents"},
+		{fsevents.CreateFlagNoDefer | 1<<10, "NoDefer|10000000000"},
+		{1 << 31, "10000000000000000000000000000000"},
+	}
+	for _, tc := range testCases {
+		if got := tc.flags.String(); got != tc.str {
+			t.Errorf("CreateFlags(%#x).String() = %q, want %q", uint32(tc.flags), got, tc.str)
+		}
+		v, err := fsevents.ParseCreateFlags(tc.str)
+		if err != nil {
+			t.Errorf("ParseCreateFlags(%q): %v", tc.str, err)
+			continue
+		}
+		if v != tc.flags {
+			t.Errorf("ParseCreateFlags(%q) = %#x, want %#x", tc.str, uint32(v), uint32(tc.flags))
+		}
+	}
+}
+
+func TestParseCreateFlagsErrors(t *testing.T) {
+	for _, s := range []string{
+		"Unknown",
+		"UseCFTypes|",
+		"useCFTypes",
+		"CreateFlagUseCFTypes",
+		"102",
+		"100000000000000000000000000000000",
+	} {
+		if v, err := fsevents.ParseCreateFlags(s); err == nil {
+			t.Errorf("ParseCreateFlags(%q) = %v, expected an error", s, v)
+		}
+	}
+}
+
+func TestEventFlagsRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	values := []fsevents.EventFlags{0, ^fsevents.EventFlags(0)}
+	for i := 0; i < 32; i++ {
+		values = append(values, 1<<i)
+	}
+	for i := 0; i < 1000; i++ {
+		values = append(values, fsevents.EventFlags(r.Uint32()))
+	}
+	for _, want := range values {
+		b, err := want.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got fsevents.EventFlags
+		if err := got.UnmarshalText(b); err != nil {
+			t.Fatalf("UnmarshalText(%q): %v", b, err)
+		}
+		if got != want {
+			t.Fatalf("UnmarshalText(%q) = %#x, want %#x", b, uint32(got), uint32(want))
+		}
+	}
+}
+
+func TestEventFlagsJSON(t *testing.T) {
+	type event struct {
+		Flags fsevents.EventFlags
+	}
+	want := event{fsevents.EventFlagItemCreated | fsevents.EventFlagItemIsFile}
+	b, err := json.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(b); s != `{"Flags":"ItemCreated|ItemIsFile"}` {
+		t.Errorf("unexpected JSON %s", s)
+	}
+	var got event
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("json.Unmarshal(%s) = %+v, want %+v", b, got, want)
+	}
+}
+
+func TestCreateFlagsFlagValue(t *testing.T) {
+	v := fsevents.CreateFlagUseCFTypes
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(&v, "flags", "")
+	if err := fs.Parse([]string{"-flags", "NoDefer|WatchRoot"}); err != nil {
+		t.Fatal(err)
+	}
+	if want := fsevents.CreateFlagNoDefer | fsevents.CreateFlagWatchRoot; v != want {
+		t.Errorf("got %v, want %v", v, want)
+	}
+	if err := fs.Parse([]string{"-flags", "NoSuchFlag"}); err == nil {
+		t.Error("expected an error for unknown flag name")
+	}
+}
@@ -20,3 +20,11 @@
 //  • https://developer.apple.com/library/archive/documentation/Darwin/Conceptual/FSEvents_ProgGuide/UsingtheFSEventsFramework/UsingtheFSEventsFramework.html
 //  • https://developer.apple.com/documentation/coreservices
 package fsevents
+
+import (
+	"github.com/noncgo/x/darwin/internal/dyld"
+)
+
+// ErrUnavailable is an error returned from functions that are not available on
+// the current OS version.
+var ErrUnavailable = dyld.ErrUnavailable
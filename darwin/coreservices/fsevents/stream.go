@@ -5,7 +5,6 @@ package fsevents
 
 import (
 	"github.com/noncgo/x/darwin/corefoundation"
-	"github.com/noncgo/x/darwin/internal/cabi"
 	"github.com/noncgo/x/darwin/internal/types"
 )
 
@@ -39,11 +38,7 @@ type StreamContext struct {
 // References
 //  • https://developer.apple.com/documentation/coreservices/1444302-fseventstreamshow
 func ShowStream(s ConstStream) {
-	cabi.Call(
-		extern_FSEventStreamShow_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(s.Pointer()),
-	)
+	fsEventStreamShow(s)
 }
 
 // RetainStream increments the stream’s reference counter.
@@ -51,11 +46,7 @@ func ShowStream(s ConstStream) {
 // References
 //  • https://developer.apple.com/documentation/coreservices/1444986-fseventstreamretain?language=objc
 func RetainStream(s Stream) {
-	cabi.Call(
-		extern_FSEventStreamRetain_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(s.Pointer()),
-	)
+	fsEventStreamRetain(s)
 }
 
 // ReleaseStream decrements the stream’s reference counter. The counter is
@@ -65,11 +56,7 @@ func RetainStream(s Stream) {
 // References
 //  • https://developer.apple.com/documentation/coreservices/1445989-fseventstreamrelease
 func ReleaseStream(s Stream) {
-	cabi.Call(
-		extern_FSEventStreamRelease_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(s.Pointer()),
-	)
+	fsEventStreamRelease(s)
 }
 
 // StartStream attempts to register with the FS Events service to receive events
@@ -78,13 +65,7 @@ func ReleaseStream(s Stream) {
 // References
 //  • https://developer.apple.com/documentation/coreservices/1448000-fseventstreamstart
 func StartStream(s Stream) bool {
-	var out bool
-	cabi.Call(
-		extern_FSEventStreamStart_trampolineABI0,
-		cabi.OutBool(&out),
-		cabi.Uintptr(s.Pointer()),
-	)
-	return out
+	return fsEventStreamStart(s)
 }
 
 // StopStream unregisters the stream from the FS Events service.
@@ -95,11 +76,7 @@ func StartStream(s Stream) bool {
 // References
 //  • https://developer.apple.com/documentation/coreservices/1447673-fseventstreamstop
 func StopStream(s Stream) {
-	cabi.Call(
-		extern_FSEventStreamStop_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(s.Pointer()),
-	)
+	fsEventStreamStop(s)
 }
 
 // ScheduleStreamWithRunLoop schedules the stream on the specified run loop.
@@ -107,13 +84,7 @@ func StopStream(s Stream) {
 // References
 //  • https://developer.apple.com/documentation/coreservices/1447824-fseventstreamschedulewithrunloop
 func ScheduleStreamWithRunLoop(s Stream, runLoop corefoundation.RunLoop, mode corefoundation.RunLoopMode) {
-	cabi.Call(
-		extern_FSEventStreamScheduleWithRunLoop_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(s.Pointer()),
-		cabi.Uintptr(runLoop.Pointer()),
-		cabi.Uintptr(mode.Pointer()),
-	)
+	fsEventStreamScheduleWithRunLoop(s, runLoop, mode)
 }
 
 // UnscheduleStreamFromRunLoop unschedules the stream from the specified run
@@ -122,13 +93,7 @@ func ScheduleStreamWithRunLoop(s Stream, runLoop corefoundation.RunLoop, mode co
 // References
 //  • https://developer.apple.com/documentation/coreservices/1441982-fseventstreamunschedulefromrunlo
 func UnscheduleStreamFromRunLoop(s Stream, runLoop corefoundation.RunLoop, mode corefoundation.RunLoopMode) {
-	cabi.Call(
-		extern_FSEventStreamUnscheduleFromRunLoop_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(s.Pointer()),
-		cabi.Uintptr(runLoop.Pointer()),
-		cabi.Uintptr(mode.Pointer()),
-	)
+	fsEventStreamUnscheduleFromRunLoop(s, runLoop, mode)
 }
 
 // InvalidateStream unschedules the stream from any run loops or dispatch queues
@@ -137,9 +102,24 @@ func UnscheduleStreamFromRunLoop(s Stream, runLoop corefoundation.RunLoop, mode
 // References
 //  • https://developer.apple.com/documentation/coreservices/1446990-fseventstreaminvalidate
 func InvalidateStream(s Stream) {
-	cabi.Call(
-		extern_FSEventStreamInvalidate_trampolineABI0,
-		cabi.Void(),
-		cabi.Uintptr(s.Pointer()),
-	)
+	fsEventStreamInvalidate(s)
+}
+
+// SetStreamExclusionPaths sets directories to be filtered from the stream. A
+// maximum of 8 directories may be specified. It returns false if the paths
+// could not be set.
+//
+// The function is available since macOS 10.9. On older systems, it returns
+// ErrUnavailable error.
+//
+// References
+//  • https://developer.apple.com/documentation/coreservices/1444569-fseventstreamsetexclusionpaths
+func SetStreamExclusionPaths(s Stream, paths corefoundation.Array) (bool, error) {
+	return fsEventStreamSetExclusionPaths(s, paths)
+}
+
+// IsSetStreamExclusionPathsAvailable returns true if SetStreamExclusionPaths
+// is available on the current system.
+func IsSetStreamExclusionPathsAvailable() bool {
+	return extern_FSEventStreamSetExclusionPaths_isAvailable()
 }
@@ -6,52 +6,92 @@
 package fsevents
 
 import (
+	"sync"
 	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/dyld"
 )
 
 const sizeofUintptr = unsafe.Sizeof(uintptr(0))
 
+// FSEventStreamRef FSEventStreamCreate(CFAllocatorRef allocator, FSEventStreamCallback callback, FSEventStreamContext *context, CFArrayRef pathsToWatch, FSEventStreamEventId sinceWhen, CFTimeInterval latency, FSEventStreamCreateFlags flags)
 var extern_FSEventStreamCreate_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamCreate FSEventStreamCreate "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamCreate_trampoline()
 
+// void FSEventStreamInvalidate(FSEventStreamRef streamRef)
 var extern_FSEventStreamInvalidate_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamInvalidate FSEventStreamInvalidate "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamInvalidate_trampoline()
 
+// void FSEventStreamRelease(FSEventStreamRef streamRef)
 var extern_FSEventStreamRelease_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamRelease FSEventStreamRelease "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamRelease_trampoline()
 
+// void FSEventStreamRetain(FSEventStreamRef streamRef)
 var extern_FSEventStreamRetain_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamRetain FSEventStreamRetain "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamRetain_trampoline()
 
+// void FSEventStreamScheduleWithRunLoop(FSEventStreamRef streamRef, CFRunLoopRef runLoop, CFStringRef runLoopMode)
 var extern_FSEventStreamScheduleWithRunLoop_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamScheduleWithRunLoop FSEventStreamScheduleWithRunLoop "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamScheduleWithRunLoop_trampoline()
 
+// void FSEventStreamShow(ConstFSEventStreamRef streamRef)
 var extern_FSEventStreamShow_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamShow FSEventStreamShow "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamShow_trampoline()
 
+// Boolean FSEventStreamStart(FSEventStreamRef streamRef)
 var extern_FSEventStreamStart_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamStart FSEventStreamStart "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamStart_trampoline()
 
+// void FSEventStreamStop(FSEventStreamRef streamRef)
 var extern_FSEventStreamStop_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamStop FSEventStreamStop "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamStop_trampoline()
 
+// void FSEventStreamUnscheduleFromRunLoop(FSEventStreamRef streamRef, CFRunLoopRef runLoop, CFStringRef runLoopMode)
 var extern_FSEventStreamUnscheduleFromRunLoop_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_FSEventStreamUnscheduleFromRunLoop FSEventStreamUnscheduleFromRunLoop "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
 func extern_FSEventStreamUnscheduleFromRunLoop_trampoline()
+
+// Weak-linked functions are resolved on the first use.
+
+//go:cgo_import_dynamic _ _ "/System/Library/Frameworks/CoreServices.framework/Versions/A/CoreServices"
+
+var weak struct {
+	FSEventStreamSetExclusionPaths_addr uintptr
+	FSEventStreamSetExclusionPaths_once sync.Once
+}
+
+// extern_FSEventStreamSetExclusionPaths_getAddr returns the function address or zero if it is not available.
+//
+//	Boolean FSEventStreamSetExclusionPaths(FSEventStreamRef streamRef, CFArrayRef pathsToExclude)
+func extern_FSEventStreamSetExclusionPaths_getAddr() uintptr {
+	weak.FSEventStreamSetExclusionPaths_once.Do(func() {
+		sym, err := dyld.Lookup("FSEventStreamSetExclusionPaths")
+		if err != nil {
+			return
+		}
+		weak.FSEventStreamSetExclusionPaths_addr = sym.Addr
+	})
+	return weak.FSEventStreamSetExclusionPaths_addr
+}
+
+// extern_FSEventStreamSetExclusionPaths_isAvailable returns true if FSEventStreamSetExclusionPaths is available at run time.
+func extern_FSEventStreamSetExclusionPaths_isAvailable() bool {
+	return extern_FSEventStreamSetExclusionPaths_getAddr() != 0
+}
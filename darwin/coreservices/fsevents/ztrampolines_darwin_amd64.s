@@ -1,7 +1,7 @@
 // Code generated by go run zgen.go. DO NOT EDIT.
 
-//go:build darwin
-// +build darwin
+//go:build darwin && amd64
+// +build darwin,amd64
 
 #include "go_asm.h"
 #include "textflag.h"
@@ -41,8 +41,8 @@
 //  │ Uint16        │ uint16         │ unsigned short         │ Arg Out │
 //  │ Uint32        │ uint32         │ unsigned int           │ Arg Out │
 //  │ Uint64        │ uint64         │ unsigned long long     │ Arg Out │
-//  │ Float32       │ float32        │ float                  │ Arg     │
-//  │ Float64       │ float64        │ double                 │ Arg     │
+//  │ Float32       │ float32        │ float                  │ Arg Out │
+//  │ Float64       │ float64        │ double                 │ Arg Out │
 //  └───────────────┴────────────────┴────────────────────────┴─────────┘
 // Note that, due to the variety of C compiler implementations, this may not
 // apply to all platforms. In particular, long type is platform-specific, i.e.
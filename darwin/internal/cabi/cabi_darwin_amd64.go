@@ -9,7 +9,9 @@ import (
 	_ "go4.org/unsafe/assume-no-moving-gc"
 )
 
-// TODO: support stack arguments
+// maxStackArgs is the maximum number of arguments that are passed on the
+// stack, i.e. that do not fit in registers.
+const maxStackArgs = 8
 
 type frame struct {
 	FuncPC uintptr
@@ -17,6 +19,21 @@ type frame struct {
 	DI, SI, DX, CX, R8, R9         uintptr
 	AX                             uintptr
 	X0, X1, X2, X3, X4, X5, X6, X7 uintptr
+
+	// NStack is the number of arguments in Stack.
+	NStack uintptr
+	Stack  [maxStackArgs]uintptr
+}
+
+// push appends an argument that is passed on the stack. Arguments that do not
+// fit in registers are passed in eightbytes in the order of appearance,
+// regardless of their class.
+func (f *frame) push(v uintptr) {
+	if f.NStack == maxStackArgs {
+		panic("cabi: too many arguments")
+	}
+	f.Stack[f.NStack] = v
+	f.NStack++
 }
 
 func callg(fn uintptr, out Out, args []Arg) {
@@ -47,11 +64,6 @@ func callg(fn uintptr, out Out, args []Arg) {
 			argTypeUint16,
 			argTypeUint32,
 			argTypeUint64:
-			if gp == numGP {
-				// TODO: push to the stack
-				// continue
-				panic("not implemented")
-			}
 			var v uintptr
 			switch arg.typ {
 			case argTypeUnsafePointer:
@@ -98,6 +110,10 @@ func callg(fn uintptr, out Out, args []Arg) {
 			case argTypeUint64:
 				v = uintptr(arg.getUint64())
 			}
+			if gp == numGP {
+				f.push(v)
+				continue
+			}
 			switch gp {
 			case 0:
 				f.DI = v
@@ -114,11 +130,6 @@ func callg(fn uintptr, out Out, args []Arg) {
 			}
 			gp++
 		case argTypeFloat32, argTypeFloat64:
-			if fp == numFP {
-				// TODO: push to the stack
-				// continue
-				panic("not implemented")
-			}
 			var v uintptr
 			switch arg.typ {
 			case argTypeFloat32:
@@ -126,6 +137,10 @@ func callg(fn uintptr, out Out, args []Arg) {
 			case argTypeFloat64:
 				v = uintptr(math.Float64bits(arg.getFloat64()))
 			}
+			if fp == numFP {
+				f.push(v)
+				continue
+			}
 			switch fp {
 			case 0:
 				f.X0 = v
@@ -183,6 +198,10 @@ func callg(fn uintptr, out Out, args []Arg) {
 		out.setUint32(uint32(f.AX))
 	case outTypeUint64:
 		out.setUint64(uint64(f.AX))
+	case outTypeFloat32:
+		out.setFloat32(math.Float32frombits(uint32(f.X0)))
+	case outTypeFloat64:
+		out.setFloat64(math.Float64frombits(uint64(f.X0)))
 	}
 }
 
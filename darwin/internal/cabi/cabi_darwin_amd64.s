@@ -8,10 +8,20 @@ DATA ·callABI0(SB)/8, $·call(SB)
 TEXT ·call(SB), NOSPLIT, $0
 	PUSHQ BP
 	MOVQ  SP, BP
-	SUBQ  $16, SP
 
 	MOVQ DI, BX
 
+	// Copy stack arguments. The stack must be 16-byte aligned at the call.
+	MOVQ frame_NStack(BX), CX
+	MOVQ CX, AX
+	SHLQ $3, AX
+	SUBQ AX, SP
+	ANDQ $~15, SP
+	LEAQ frame_Stack(BX), SI
+	MOVQ SP, DI
+	CLD
+	REP; MOVSQ
+
 	MOVQ  frame_DI(BX), DI
 	MOVQ  frame_SI(BX), SI
 	MOVQ  frame_DX(BX), DX
@@ -32,6 +42,7 @@ TEXT ·call(SB), NOSPLIT, $0
 
 	MOVQ AX, frame_AX(BX)
 	MOVQ DX, frame_DX(BX)
+	MOVSD X0, frame_X0(BX)
 
 	MOVQ BP, SP
 	POPQ BP
@@ -0,0 +1,134 @@
+//go:build darwin && amd64
+// +build darwin,amd64
+
+package cabi_test
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/noncgo/x/darwin/internal/cabi"
+	"github.com/noncgo/x/darwin/internal/dyld"
+)
+
+// snprintf calls the C function with arguments for the format verbs and
+// returns the formatted string. The buffer, size and format occupy the first
+// three integer registers, so the remaining three integer arguments and eight
+// floating-point arguments are passed in registers and the rest on the stack.
+func snprintf(t *testing.T, format string, args ...cabi.Arg) string {
+	t.Helper()
+	sym, err := dyld.Lookup("snprintf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 1024)
+	var n int32
+	cabi.Call(sym.Addr, cabi.OutInt32(&n), append([]cabi.Arg{
+		cabi.Bytes(buf),
+		cabi.Uint(uint(len(buf))),
+		cabi.String(format + "\x00"),
+	}, args...)...)
+	if n < 0 || int(n) >= len(buf) {
+		t.Fatalf("snprintf returned %d", n)
+	}
+	if i := bytes.IndexByte(buf, 0); i != int(n) {
+		t.Fatalf("snprintf returned %d, but the string has length %d", n, i)
+	}
+	return string(buf[:n])
+}
+
+func TestCallIntArgs(t *testing.T) {
+	// Up to 8 integer arguments on the stack, i.e. both odd and even
+	// counts that require padding to keep the stack aligned.
+	for n := 0; n <= 11; n++ {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			var args []cabi.Arg
+			var verbs, want []string
+			for i := 0; i < n; i++ {
+				v := -(i + 1) * 1001
+				args = append(args, cabi.Int(v))
+				verbs = append(verbs, "%ld")
+				want = append(want, strconv.Itoa(v))
+			}
+			got := snprintf(t, strings.Join(verbs, " "), args...)
+			if w := strings.Join(want, " "); got != w {
+				t.Errorf("got %q, want %q", got, w)
+			}
+		})
+	}
+}
+
+func TestCallFloatArgs(t *testing.T) {
+	for n := 0; n <= 13; n++ {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			var args []cabi.Arg
+			var verbs, want []string
+			for i := 0; i < n; i++ {
+				v := float64(i) + 0.25
+				args = append(args, cabi.Float64(v))
+				verbs = append(verbs, "%g")
+				want = append(want, strconv.FormatFloat(v, 'g', -1, 64))
+			}
+			got := snprintf(t, strings.Join(verbs, " "), args...)
+			if w := strings.Join(want, " "); got != w {
+				t.Errorf("got %q, want %q", got, w)
+			}
+		})
+	}
+}
+
+func TestCallMixedArgs(t *testing.T) {
+	// Interleaved arguments spill to the stack in the order of appearance,
+	// regardless of their class.
+	testCases := []struct {
+		ints, floats int
+	}{
+		{4, 8},  // 1 integer on the stack
+		{3, 9},  // 1 float on the stack
+		{4, 9},  // 2 arguments on the stack
+		{5, 10}, // 4 arguments on the stack
+		{6, 10}, // 5 arguments on the stack
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%d_%d", tc.ints, tc.floats), func(t *testing.T) {
+			var args []cabi.Arg
+			var verbs, want []string
+			for i, j := 0, 0; i < tc.ints || j < tc.floats; {
+				if i < tc.ints {
+					v := int8(-i - 1)
+					args = append(args, cabi.Int8(v))
+					verbs = append(verbs, "%d")
+					want = append(want, strconv.Itoa(int(v)))
+					i++
+				}
+				if j < tc.floats {
+					v := float64(j) * 1.5
+					args = append(args, cabi.Float64(v))
+					verbs = append(verbs, "%g")
+					want = append(want, strconv.FormatFloat(v, 'g', -1, 64))
+					j++
+				}
+			}
+			got := snprintf(t, strings.Join(verbs, " "), args...)
+			if w := strings.Join(want, " "); got != w {
+				t.Errorf("got %q, want %q", got, w)
+			}
+		})
+	}
+}
+
+func TestCallTooManyArgs(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	args := make([]cabi.Arg, 6+8+1)
+	for i := range args {
+		args[i] = cabi.Int(0)
+	}
+	cabi.Call(0, cabi.Void(), args...)
+}
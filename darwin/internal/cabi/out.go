@@ -23,6 +23,8 @@ const (
 	outTypeUint16
 	outTypeUint32
 	outTypeUint64
+	outTypeFloat32
+	outTypeFloat64
 )
 
 // Out is a function call output value.
@@ -134,6 +136,22 @@ func OutUint64(p *uint64) Out {
 	}
 }
 
+// OutFloat32 returns a function call output value for float32 type.
+func OutFloat32(p *float32) Out {
+	return Out{
+		typ: outTypeFloat32,
+		val: unsafe.Pointer(p),
+	}
+}
+
+// OutFloat64 returns a function call output value for float64 type.
+func OutFloat64(p *float64) Out {
+	return Out{
+		typ: outTypeFloat64,
+		val: unsafe.Pointer(p),
+	}
+}
+
 func (o *Out) setUintptr(v uintptr) {
 	*(*uintptr)(o.val) = v
 }
@@ -181,3 +199,11 @@ func (o *Out) setUint32(v uint32) {
 func (o *Out) setUint64(v uint64) {
 	*(*uint64)(o.val) = v
 }
+
+func (o *Out) setFloat32(v float32) {
+	*(*float32)(o.val) = v
+}
+
+func (o *Out) setFloat64(v float64) {
+	*(*float64)(o.val) = v
+}
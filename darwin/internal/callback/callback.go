@@ -0,0 +1,147 @@
+//go:build darwin && amd64
+// +build darwin,amd64
+
+// Package callback provides C function pointers that call back into Go.
+//
+// C APIs accept function pointers for callbacks, e.g. CFArrayCallBacks or
+// CFRunLoopSourceContext. Go functions cannot be passed to C directly without
+// Cgo, so the package reserves a fixed number of entry points, or slots, in
+// assembly. Each slot is a CALL instruction to a common dispatcher that
+// computes the slot index from the return address, transitions from C to Go
+// using runtime·cgocallback and invokes the Go function registered for the
+// slot. This is similar to how the runtime implements syscall.NewCallback on
+// Windows.
+//
+// Callbacks receive up to NumArgs integer or pointer arguments passed in
+// registers and return a single integer or pointer result. Floating-point
+// arguments and results are not supported.
+//
+// Callbacks must be invoked on threads created by the Go runtime, e.g. from a
+// C function called using cabi package or from a run loop running on a
+// goroutine locked to its thread. Calls on other threads, e.g. dispatch queue
+// workers, do not call the Go function and return zero to the caller, so C
+// code observes a callback that does nothing, e.g. a deallocation that leaks
+// memory or an allocation that fails.
+//
+// References
+//  • https://github.com/golang/go/blob/master/src/runtime/syscall_windows.go
+package callback
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+)
+
+// NumSlots is the maximum number of callbacks that can exist at the same time.
+const NumSlots = 1024
+
+// NumArgs is the number of arguments passed to Func.
+const NumArgs = 6
+
+// slotSize is the size of CALL instruction in slots.
+const slotSize = 5
+
+// Args contains integer and pointer arguments of a callback in the order of C
+// function parameters. Arguments beyond the number of parameters are
+// undefined.
+type Args [NumArgs]uintptr
+
+// Func is a Go function that implements a C callback. The result is ignored
+// for C functions that return void.
+type Func func(args Args) uintptr
+
+// ErrNoSlots is returned by New if all slots are in use.
+var ErrNoSlots = errors.New("callback: no free slots")
+
+var registry struct {
+	sync.Mutex
+	funcs [NumSlots]Func
+	// next is the index where the search for a free slot starts.
+	next int
+}
+
+// New returns a C function pointer that calls fn. The pointer remains valid
+// until it is passed to Free.
+func New(fn Func) (uintptr, error) {
+	if fn == nil {
+		panic("callback: nil func")
+	}
+	registry.Lock()
+	defer registry.Unlock()
+	for n := 0; n < NumSlots; n++ {
+		i := (registry.next + n) % NumSlots
+		if registry.funcs[i] != nil {
+			continue
+		}
+		registry.funcs[i] = fn
+		registry.next = (i + 1) % NumSlots
+		return slotsABI0 + uintptr(i)*slotSize, nil
+	}
+	return 0, ErrNoSlots
+}
+
+// Free releases the slot for the function pointer returned by New. It is a
+// no-op if fn is zero.
+func Free(fn uintptr) {
+	if fn == 0 {
+		return
+	}
+	i := slotIndex(fn)
+	registry.Lock()
+	defer registry.Unlock()
+	if registry.funcs[i] == nil {
+		panic("callback: free of unused slot")
+	}
+	registry.funcs[i] = nil
+}
+
+// slotIndex returns the index of the slot at the given address.
+func slotIndex(fn uintptr) int {
+	if fn < slotsABI0 || (fn-slotsABI0)%slotSize != 0 || (fn-slotsABI0)/slotSize >= NumSlots {
+		panic("callback: invalid function pointer")
+	}
+	return int((fn - slotsABI0) / slotSize)
+}
+
+// frame carries arguments and the result between dispatch in assembly and
+// Go code.
+//
+// It is allocated on the system stack in dispatch.
+//
+//go:notinheap
+type frame struct {
+	// pc is the return address of the CALL instruction in slots, i.e. the
+	// address of the next slot.
+	pc     uintptr
+	args   Args
+	result uintptr
+}
+
+// dispatchGo invokes the Go function registered for the slot.
+//
+// It is called indirectly from runtime·cgocallbackg1 that uses ABIInternal
+// calling convention, see callbackABIInternal in fsevents package.
+func dispatchGo(f *frame) {
+	i := slotIndex(f.pc - slotSize)
+	registry.Lock()
+	fn := registry.funcs[i]
+	registry.Unlock()
+	if fn == nil {
+		panic("callback: call to unused slot")
+	}
+	f.result = fn(f.args)
+}
+
+// dispatchGoABIInternal is a PC of dispatchGo that we use in dispatch.
+//nolint:unused // used in assembly
+var dispatchGoABIInternal = reflect.ValueOf(dispatchGo).Pointer()
+
+//nolint:unused // implemented in assembly
+func slots()
+
+//nolint:unused // implemented in assembly
+func dispatch()
+
+// slotsABI0 is the address of the first slot.
+var slotsABI0 uintptr
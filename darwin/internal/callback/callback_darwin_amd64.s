@@ -0,0 +1,74 @@
+//go:build darwin && amd64
+// +build darwin,amd64
+
+#include "go_asm.h"
+#include "textflag.h"
+#include "../../src/runtime/cgo/abi_amd64.h"
+#include "../../src/runtime/go_tls.h"
+
+GLOBL ·slotsABI0(SB), NOPTR|RODATA, $8
+DATA ·slotsABI0(SB)/8, $·slots(SB)
+
+// dispatch is the common implementation of C callbacks. It is called from a
+// slot with the slot return address on top of the stack.
+//
+// Registers:
+//  DI SI DX CX R8 R9 callback arguments
+//
+TEXT ·dispatch(SB), NOSPLIT|NOFRAME, $0-0
+	// Pop the return address into slots so that the stack is the same as
+	// on entry to the slot. Note that the assembler does not track SP
+	// adjustment by ADDQ.
+	MOVQ (SP), AX
+	ADDQ $8, SP
+
+	// Without Cgo, runtime·cgocallback cannot set up threads that were not
+	// created by Go, i.e. threads without g. Return zero to the caller
+	// without calling the Go function on such threads. R11 is a scratch
+	// register in C ABI.
+	get_tls(R11)
+	MOVQ g(R11), R11
+	TESTQ R11, R11
+	JZ   foreign
+
+	// Transition from C ABI to Go ABI.
+	PUSH_REGS_HOST_TO_ABI0()
+
+	ADJSP $frame__size
+	MOVQ  AX, frame_pc(SP)
+	MOVQ  DI, (frame_args+0*8)(SP)
+	MOVQ  SI, (frame_args+1*8)(SP)
+	MOVQ  DX, (frame_args+2*8)(SP)
+	MOVQ  CX, (frame_args+3*8)(SP)
+	MOVQ  R8, (frame_args+4*8)(SP)
+	MOVQ  R9, (frame_args+5*8)(SP)
+	MOVQ  $0, frame_result(SP)
+	LEAQ  (SP), SI
+
+	ADJSP $3*8
+	MOVQ  ·dispatchGoABIInternal(SB), AX
+	MOVQ  AX, (0*8)(SP)
+	MOVQ  SI, (1*8)(SP)
+	MOVQ  $0, (2*8)(SP)
+	CALL  runtime·cgocallback(SB)
+	ADJSP $-3*8
+
+	MOVQ  frame_result(SP), AX
+	ADJSP $-frame__size
+	POP_REGS_HOST_TO_ABI0()
+	RET
+
+foreign:
+	XORQ AX, AX
+	RET
+
+#define SLOT CALL ·dispatch(SB)
+#define SLOT4 SLOT; SLOT; SLOT; SLOT
+#define SLOT16 SLOT4; SLOT4; SLOT4; SLOT4
+#define SLOT64 SLOT16; SLOT16; SLOT16; SLOT16
+#define SLOT256 SLOT64; SLOT64; SLOT64; SLOT64
+
+// slots is an array of NumSlots entry points. Each slot is a 5-byte CALL
+// instruction, so the return address identifies the slot.
+TEXT ·slots(SB), NOSPLIT|NOFRAME, $0-0
+	SLOT256; SLOT256; SLOT256; SLOT256
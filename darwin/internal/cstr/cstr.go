@@ -3,10 +3,41 @@
 package cstr
 
 import (
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
 	"strings"
+	"unicode/utf8"
 	"unsafe"
 )
 
+var (
+	// ErrNull is an error returned from GoStringChecked when the given
+	// pointer is NULL.
+	ErrNull = errors.New("null pointer")
+
+	// ErrTooLong is an error returned from GoStringChecked when there is
+	// no null terminator within the given number of bytes.
+	ErrTooLong = errors.New("string is not null-terminated within the limit")
+
+	// ErrInvalidUTF8 is an error returned from GoStringChecked when the
+	// string contains invalid UTF-8 byte sequences.
+	ErrInvalidUTF8 = errors.New("string is not valid UTF-8")
+)
+
+// FaultError is an error returned from GoStringChecked when reading memory at
+// the given pointer results in a fault.
+type FaultError struct {
+	// Addr is the faulting address, if known.
+	Addr uintptr
+}
+
+// Error implements the error interface.
+func (e *FaultError) Error() string {
+	return fmt.Sprintf("memory fault at address %#x", e.Addr)
+}
+
 // Unpack returns the underlying string data pointer and length.
 func Unpack(s *string) (str unsafe.Pointer, len int) {
 	// stringHeader is a safer version of reflect.StringHeader.
@@ -37,10 +68,102 @@ func GoStringN(p uintptr, n int) (s string) {
 
 // GoString copies null-terminated C string from unmanaged memory to GC-managed
 // string. The returned string does not contain null byte.
+//
+// GoString does not limit the number of bytes it reads. Prefer GoStringMax or
+// GoStringChecked for strings that come from untrusted sources.
 func GoString(p uintptr) string {
 	return runtime_gostring(*(**byte)(unsafe.Pointer(&p)))
 }
 
+// GoStringMax is like GoString but reads at most max bytes. If there is no
+// null terminator within max bytes, the returned string contains exactly max
+// bytes. It returns an empty string if p is NULL.
+func GoStringMax(p uintptr, max int) string {
+	if p == 0 || max <= 0 {
+		return ""
+	}
+	return string(unsafe.Slice(bytePtr(p), strlen(p, max)))
+}
+
+// GoStringChecked is like GoStringMax but returns an error if p is NULL, if
+// there is no null terminator within max bytes, if the string is not valid
+// UTF-8, or if reading memory at p results in a fault.
+//
+// Note that the check for memory faults is best-effort. It relies on the
+// memory being unmapped, and does not detect reads of mapped memory that does
+// not belong to the string.
+func GoStringChecked(p uintptr, max int) (s string, err error) {
+	if p == 0 {
+		return "", ErrNull
+	}
+	n := max
+	if err := catchFault(func() {
+		n = strlen(p, max)
+		if n < max {
+			s = string(unsafe.Slice(bytePtr(p), n))
+		}
+	}); err != nil {
+		return "", err
+	}
+	if n == max {
+		return "", ErrTooLong
+	}
+	if !utf8.ValidString(s) {
+		return "", ErrInvalidUTF8
+	}
+	return s, nil
+}
+
+// GoBytes copies n bytes from unmanaged memory to GC-managed byte slice. It
+// returns nil if p is NULL or n is not positive.
+func GoBytes(p uintptr, n int) []byte {
+	if p == 0 || n <= 0 {
+		return nil
+	}
+	b := make([]byte, n)
+	copy(b, unsafe.Slice(bytePtr(p), n))
+	return b
+}
+
+// strlen returns the index of the first null byte at p or max if there is no
+// null byte within max bytes.
+func strlen(p uintptr, max int) int {
+	base := unsafe.Pointer(bytePtr(p))
+	for i := 0; i < max; i++ {
+		if *(*byte)(unsafe.Add(base, i)) == 0 {
+			return i
+		}
+	}
+	return max
+}
+
+// catchFault calls f and converts memory faults into FaultError.
+func catchFault(f func()) (err error) {
+	defer debug.SetPanicOnFault(debug.SetPanicOnFault(true))
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		e, ok := r.(runtime.Error)
+		if !ok {
+			panic(r)
+		}
+		var addr uintptr
+		if a, ok := e.(interface{ Addr() uintptr }); ok {
+			addr = a.Addr()
+		}
+		err = &FaultError{Addr: addr}
+	}()
+	f()
+	return nil
+}
+
+// bytePtr converts p to a byte pointer without triggering unsafeptr check.
+func bytePtr(p uintptr) *byte {
+	return *(**byte)(unsafe.Pointer(&p))
+}
+
 //go:linkname runtime_gostring runtime.gostring
 func runtime_gostring(p *byte) string // from runtime/string.go
 
@@ -0,0 +1,150 @@
+//go:build go1.18
+// +build go1.18
+
+package cstr_test
+
+import (
+	"bytes"
+	"errors"
+	"runtime"
+	"testing"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/noncgo/x/darwin/internal/cstr"
+)
+
+// addr returns the address of the first byte in b.
+func addr(b []byte) uintptr {
+	return uintptr(unsafe.Pointer(&b[0]))
+}
+
+// boundedString returns the expected result of GoStringMax for the given
+// buffer and limit.
+func boundedString(b []byte, max int) string {
+	if max > len(b) {
+		max = len(b)
+	}
+	if max < 0 {
+		max = 0
+	}
+	b = b[:max]
+	if i := bytes.IndexByte(b, 0); i != -1 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+func FuzzGoStringMax(f *testing.F) {
+	f.Add([]byte("hello\x00world"), 16)
+	f.Add([]byte("hello"), 3)
+	f.Add([]byte{0}, 1)
+	f.Add([]byte("\xff\xfe\x00"), 0)
+	f.Fuzz(func(t *testing.T, data []byte, max int) {
+		// Copy data to a buffer with a trailing guard byte to make
+		// sure we never read past the limit.
+		buf := make([]byte, len(data)+1)
+		copy(buf, data)
+		buf[len(data)] = 0xff
+		if max > len(data) {
+			max = len(data)
+		}
+
+		got := cstr.GoStringMax(addr(buf), max)
+		runtime.KeepAlive(buf)
+
+		if want := boundedString(data, max); got != want {
+			t.Fatalf("GoStringMax(%q, %d) = %q, expected %q", data, max, got, want)
+		}
+	})
+}
+
+func FuzzGoStringChecked(f *testing.F) {
+	f.Add([]byte("hello\x00world"), 16)
+	f.Add([]byte("hello"), 5)
+	f.Add([]byte("\xff\x00"), 2)
+	f.Add([]byte("\xe2\x80\xa2\x00"), 4)
+	f.Fuzz(func(t *testing.T, data []byte, max int) {
+		buf := make([]byte, len(data)+1)
+		copy(buf, data)
+		buf[len(data)] = 0xff
+		if max > len(data) {
+			max = len(data)
+		}
+
+		got, err := cstr.GoStringChecked(addr(buf), max)
+		runtime.KeepAlive(buf)
+
+		bounded := data
+		if max >= 0 {
+			bounded = data[:max]
+		}
+		i := bytes.IndexByte(bounded, 0)
+		switch {
+		case max <= 0 || i == -1:
+			if !errors.Is(err, cstr.ErrTooLong) {
+				t.Fatalf("GoStringChecked(%q, %d) = %q, %v, expected ErrTooLong", data, max, got, err)
+			}
+		case !utf8.Valid(bounded[:i]):
+			if !errors.Is(err, cstr.ErrInvalidUTF8) {
+				t.Fatalf("GoStringChecked(%q, %d) = %q, %v, expected ErrInvalidUTF8", data, max, got, err)
+			}
+		default:
+			if err != nil {
+				t.Fatalf("GoStringChecked(%q, %d): unexpected error: %v", data, max, err)
+			}
+			if want := string(bounded[:i]); got != want {
+				t.Fatalf("GoStringChecked(%q, %d) = %q, expected %q", data, max, got, want)
+			}
+		}
+	})
+}
+
+func FuzzGoBytes(f *testing.F) {
+	f.Add([]byte("hello\x00world"), 11)
+	f.Add([]byte("hello"), 2)
+	f.Add([]byte{0}, 0)
+	f.Fuzz(func(t *testing.T, data []byte, n int) {
+		if len(data) == 0 {
+			return
+		}
+		if n > len(data) {
+			n = len(data)
+		}
+		buf := append([]byte(nil), data...)
+
+		got := cstr.GoBytes(addr(buf), n)
+		runtime.KeepAlive(buf)
+
+		if n <= 0 {
+			if got != nil {
+				t.Fatalf("GoBytes(%q, %d) = %q, expected nil", data, n, got)
+			}
+			return
+		}
+		if !bytes.Equal(got, data[:n]) {
+			t.Fatalf("GoBytes(%q, %d) = %q, expected %q", data, n, got, data[:n])
+		}
+		// The result must not alias the source buffer.
+		buf[0] ^= 0xff
+		if got[0] != data[0] {
+			t.Fatal("GoBytes must return a copy of the memory")
+		}
+	})
+}
+
+func TestGoStringCheckedNull(t *testing.T) {
+	if _, err := cstr.GoStringChecked(0, 1); !errors.Is(err, cstr.ErrNull) {
+		t.Fatalf("expected ErrNull, got %v", err)
+	}
+}
+
+func TestGoStringCheckedFault(t *testing.T) {
+	// The last page of address space is never mapped in user space.
+	const p = ^uintptr(0) &^ 0xfff
+	_, err := cstr.GoStringChecked(p, 16)
+	var fault *cstr.FaultError
+	if !errors.As(err, &fault) {
+		t.Fatalf("expected FaultError, got %v", err)
+	}
+}
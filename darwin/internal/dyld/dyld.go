@@ -8,6 +8,7 @@ import (
 	"errors"
 	"runtime"
 	"syscall"
+	"unsafe"
 
 	"github.com/noncgo/x/darwin/internal/cstr"
 )
@@ -16,6 +17,10 @@ import (
 // for the given address.
 var ErrNotFound = errors.New("no image found for the given address")
 
+// ErrUnavailable is an error returned from wrappers of weak-linked functions
+// when the function is not available at run time, e.g. on older OS versions.
+var ErrUnavailable = errors.New("symbol is not available on this system")
+
 const (
 	// BindLazy specifies the binding mode for loading an image.
 	//
@@ -211,7 +216,7 @@ func Open(path string, mode int) (*Image, error) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	handle := dlopen(p, mode)
+	handle := dlopen(p, int32(mode))
 	if handle <= 0 {
 		return nil, lastError()
 	}
@@ -324,7 +329,7 @@ func Addr(p uintptr) (info *AddrInfo, err error) {
 	defer runtime.UnlockOSThread()
 
 	var dli dyldInfo
-	ret := dladdr(p, &dli)
+	ret := dladdr(p, unsafe.Pointer(&dli))
 	if ret != 0 {
 		fname := gostring(dli.fname)
 		sname := gostring(dli.sname)
@@ -366,6 +371,11 @@ func cstring(s string) (*byte, error) {
 	return p, nil
 }
 
+// maxStringLen is the maximum length of strings returned from dyld functions.
+// It is large enough to hold any path (PATH_MAX is 1024 on Darwin) along with
+// the error message.
+const maxStringLen = 4096
+
 func gostring(p uintptr) string {
-	return cstr.GoString(p)
+	return cstr.GoStringMax(p, maxStringLen)
 }
@@ -11,31 +11,37 @@ import (
 
 const sizeofUintptr = unsafe.Sizeof(uintptr(0))
 
+// int dladdr(const void *addr, Dl_info *info)
 var extern_dladdr_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_dladdr dladdr "/usr/lib/libSystem.B.dylib"
 func extern_dladdr_trampoline()
 
+// int dlclose(void *handle)
 var extern_dlclose_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_dlclose dlclose "/usr/lib/libSystem.B.dylib"
 func extern_dlclose_trampoline()
 
+// char *dlerror(void)
 var extern_dlerror_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_dlerror dlerror "/usr/lib/libSystem.B.dylib"
 func extern_dlerror_trampoline()
 
+// void *dlopen(const char *path, int mode)
 var extern_dlopen_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_dlopen dlopen "/usr/lib/libSystem.B.dylib"
 func extern_dlopen_trampoline()
 
+// bool dlopen_preflight(const char *path)
 var extern_dlopen_preflight_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_dlopen_preflight dlopen_preflight "/usr/lib/libSystem.B.dylib"
 func extern_dlopen_preflight_trampoline()
 
+// void *dlsym(void *handle, const char *symbol)
 var extern_dlsym_trampolineABI0 uintptr
 
 //go:cgo_import_dynamic extern_dlsym dlsym "/usr/lib/libSystem.B.dylib"
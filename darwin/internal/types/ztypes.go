@@ -23,21 +23,57 @@ func (p Pointer) privateCFAllocator() {}
 // privateCFArray implements the CFArray interface.
 func (p Pointer) privateCFArray() {}
 
+// privateCFBoolean implements the CFBoolean interface.
+func (p Pointer) privateCFBoolean() {}
+
 // privateCFData implements the CFData interface.
 func (p Pointer) privateCFData() {}
 
+// privateCFDate implements the CFDate interface.
+func (p Pointer) privateCFDate() {}
+
 // privateCFDictionary implements the CFDictionary interface.
 func (p Pointer) privateCFDictionary() {}
 
+// privateCFError implements the CFError interface.
+func (p Pointer) privateCFError() {}
+
 // privateCFMutableArray implements the CFMutableArray interface.
 func (p Pointer) privateCFMutableArray() {}
 
+// privateCFMutableData implements the CFMutableData interface.
+func (p Pointer) privateCFMutableData() {}
+
+// privateCFMutableDictionary implements the CFMutableDictionary interface.
+func (p Pointer) privateCFMutableDictionary() {}
+
+// privateCFNull implements the CFNull interface.
+func (p Pointer) privateCFNull() {}
+
+// privateCFNumber implements the CFNumber interface.
+func (p Pointer) privateCFNumber() {}
+
+// privateCFReadStream implements the CFReadStream interface.
+func (p Pointer) privateCFReadStream() {}
+
 // privateCFRunLoop implements the CFRunLoop interface.
 func (p Pointer) privateCFRunLoop() {}
 
+// privateCFRunLoopObserver implements the CFRunLoopObserver interface.
+func (p Pointer) privateCFRunLoopObserver() {}
+
+// privateCFRunLoopSource implements the CFRunLoopSource interface.
+func (p Pointer) privateCFRunLoopSource() {}
+
+// privateCFRunLoopTimer implements the CFRunLoopTimer interface.
+func (p Pointer) privateCFRunLoopTimer() {}
+
 // privateCFString implements the CFString interface.
 func (p Pointer) privateCFString() {}
 
+// privateCFWriteStream implements the CFWriteStream interface.
+func (p Pointer) privateCFWriteStream() {}
+
 // privateConstFSEventStreamRef implements the ConstFSEventStreamRef interface.
 func (p Pointer) privateConstFSEventStreamRef() {}
 
@@ -73,6 +109,14 @@ type CFArray interface {
 	privateCFArray()
 }
 
+// CFBoolean is an opaque reference to CFBoolean type.
+type CFBoolean interface {
+	AnyObject
+	CFType
+
+	privateCFBoolean()
+}
+
 // CFData is an opaque reference to CFData type.
 type CFData interface {
 	AnyObject
@@ -81,6 +125,14 @@ type CFData interface {
 	privateCFData()
 }
 
+// CFDate is an opaque reference to CFDate type.
+type CFDate interface {
+	AnyObject
+	CFType
+
+	privateCFDate()
+}
+
 // CFDictionary is an opaque reference to CFDictionary type.
 type CFDictionary interface {
 	AnyObject
@@ -89,6 +141,14 @@ type CFDictionary interface {
 	privateCFDictionary()
 }
 
+// CFError is an opaque reference to CFError type.
+type CFError interface {
+	AnyObject
+	CFType
+
+	privateCFError()
+}
+
 // CFMutableArray is an opaque reference to CFMutableArray type.
 type CFMutableArray interface {
 	AnyObject
@@ -97,6 +157,46 @@ type CFMutableArray interface {
 	privateCFMutableArray()
 }
 
+// CFMutableData is an opaque reference to CFMutableData type.
+type CFMutableData interface {
+	AnyObject
+	CFData
+
+	privateCFMutableData()
+}
+
+// CFMutableDictionary is an opaque reference to CFMutableDictionary type.
+type CFMutableDictionary interface {
+	AnyObject
+	CFDictionary
+
+	privateCFMutableDictionary()
+}
+
+// CFNull is an opaque reference to CFNull type.
+type CFNull interface {
+	AnyObject
+	CFType
+
+	privateCFNull()
+}
+
+// CFNumber is an opaque reference to CFNumber type.
+type CFNumber interface {
+	AnyObject
+	CFType
+
+	privateCFNumber()
+}
+
+// CFReadStream is an opaque reference to CFReadStream type.
+type CFReadStream interface {
+	AnyObject
+	CFType
+
+	privateCFReadStream()
+}
+
 // CFRunLoop is an opaque reference to CFRunLoop type.
 type CFRunLoop interface {
 	CFType
@@ -104,6 +204,27 @@ type CFRunLoop interface {
 	privateCFRunLoop()
 }
 
+// CFRunLoopObserver is an opaque reference to CFRunLoopObserver type.
+type CFRunLoopObserver interface {
+	CFType
+
+	privateCFRunLoopObserver()
+}
+
+// CFRunLoopSource is an opaque reference to CFRunLoopSource type.
+type CFRunLoopSource interface {
+	CFType
+
+	privateCFRunLoopSource()
+}
+
+// CFRunLoopTimer is an opaque reference to CFRunLoopTimer type.
+type CFRunLoopTimer interface {
+	CFType
+
+	privateCFRunLoopTimer()
+}
+
 // CFString is an opaque reference to CFString type.
 type CFString interface {
 	AnyObject
@@ -112,6 +233,14 @@ type CFString interface {
 	privateCFString()
 }
 
+// CFWriteStream is an opaque reference to CFWriteStream type.
+type CFWriteStream interface {
+	AnyObject
+	CFType
+
+	privateCFWriteStream()
+}
+
 // ConstFSEventStreamRef is an opaque reference to ConstFSEventStreamRef type.
 type ConstFSEventStreamRef interface {
 	Pointer() uintptr
@@ -0,0 +1,76 @@
+package zgen
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DefaultMinMacOS is the oldest macOS version that generated code supports if
+// Config.MinMacOS is not set. It is the oldest version supported by Go 1.17.
+const DefaultMinMacOS = "10.13"
+
+// availabilityPatterns match the macOS version in availability annotations,
+// e.g. “API_AVAILABLE(macos(10.15))”, “__OSX_AVAILABLE_STARTING(__MAC_10_5,
+// __IPHONE_6_0)”, “CF_AVAILABLE_MAC(10_7)” and
+// “AVAILABLE_MAC_OS_X_VERSION_10_6_AND_LATER”. The first submatch is the
+// version with components separated by dots or underscores.
+var availabilityPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\bmacosx?\(\s*([0-9]+(?:\.[0-9]+)*)\s*\)`),
+	regexp.MustCompile(`\b__MAC_([0-9]+(?:_[0-9]+)*)\b`),
+	regexp.MustCompile(`\bCF_AVAILABLE(?:_MAC)?\(\s*([0-9]+(?:_[0-9]+)*)`),
+	regexp.MustCompile(`\bAVAILABLE_MAC_OS_X_VERSION_([0-9]+(?:_[0-9]+)*)_AND_LATER\b`),
+}
+
+// introducedMacOS returns the macOS version that introduced the declaration
+// with the given availability annotation. It returns false if the annotation
+// does not specify the version.
+func introducedMacOS(availability string) ([]int, bool) {
+	for _, re := range availabilityPatterns {
+		m := re.FindStringSubmatch(availability)
+		if m == nil {
+			continue
+		}
+		v, err := parseVersion(strings.Replace(m[1], "_", ".", -1))
+		if err != nil {
+			continue
+		}
+		return v, true
+	}
+	return nil, false
+}
+
+// parseVersion parses version components separated by dots, e.g. “10.15”.
+func parseVersion(s string) ([]int, error) {
+	var v []int
+	for _, x := range strings.Split(s, ".") {
+		n, err := strconv.Atoi(x)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid version %q", s)
+		}
+		v = append(v, n)
+	}
+	return v, nil
+}
+
+// compareVersions returns -1, 0 or +1 depending on whether a is less than,
+// equal to or greater than b. Missing components are zero.
+func compareVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return +1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,429 @@
+package zgen
+
+import (
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// scalarTypes maps C scalar types to Go types. Library-specific scalar types
+// are declared in zctypes.txt mapping file.
+//
+// Note that the mapping assumes LP64 data model.
+var scalarTypes = map[string]string{
+	"_Bool":              "bool",
+	"bool":               "bool",
+	"char":               "int8",
+	"signed char":        "int8",
+	"unsigned char":      "uint8",
+	"short":              "int16",
+	"signed short":       "int16",
+	"unsigned short":     "uint16",
+	"int":                "int32",
+	"signed":             "int32",
+	"signed int":         "int32",
+	"unsigned":           "uint32",
+	"unsigned int":       "uint32",
+	"long":               "int",
+	"signed long":        "int",
+	"unsigned long":      "uint",
+	"long long":          "int64",
+	"signed long long":   "int64",
+	"unsigned long long": "uint64",
+	"float":              "float32",
+	"double":             "float64",
+	"int8_t":             "int8",
+	"int16_t":            "int16",
+	"int32_t":            "int32",
+	"int64_t":            "int64",
+	"uint8_t":            "uint8",
+	"uint16_t":           "uint16",
+	"uint32_t":           "uint32",
+	"uint64_t":           "uint64",
+	"intptr_t":           "int",
+	"uintptr_t":          "uintptr",
+	"ssize_t":            "int",
+	"size_t":             "uint",
+}
+
+// goScalarTypes are Go types that C scalar types may map to.
+var goScalarTypes = map[string]bool{
+	"bool":    true,
+	"int":     true,
+	"int8":    true,
+	"int16":   true,
+	"int32":   true,
+	"int64":   true,
+	"uint":    true,
+	"uint8":   true,
+	"uint16":  true,
+	"uint32":  true,
+	"uint64":  true,
+	"uintptr": true,
+	"float32": true,
+	"float64": true,
+}
+
+// structField is a field of a C structure that is passed by value.
+type structField struct {
+	Name string
+	Type string // Go scalar type
+}
+
+// maxIntArgs is the number of general-purpose registers used to pass integer
+// and pointer arguments on amd64. It is less than on arm64.
+const maxIntArgs = 6
+
+// TypeMap maps C types that are declared by libraries to Go representation.
+// The zero value maps only C scalar types. A nil *TypeMap is equivalent to
+// the zero value.
+type TypeMap struct {
+	// scalars maps C scalar typedefs to Go types.
+	scalars map[string]string
+	// objects maps C object reference types to internal/types interfaces.
+	objects map[string]string
+	// structs maps C structures that are passed by value to their fields.
+	//
+	// Structures may only consist of integer fields, so both amd64 and
+	// arm64 C ABIs pass them in consecutive general-purpose registers,
+	// i.e. as if the fields were separate arguments. That is only true if
+	// all fields fit in registers, see maxIntArgs.
+	structs map[string][]structField
+	// funcs are C function pointer types.
+	funcs map[string]bool
+	// memory are void pointer parameters, in “function.parameter” form,
+	// that point to a buffer provided by the caller rather than to
+	// unmanaged memory or an opaque value. Unlike other void pointers, they
+	// map to unsafe.Pointer so that the buffer may be in Go memory.
+	memory map[string]bool
+
+	// header, if not nil, resolves other type names using typedefs.
+	header *Header
+}
+
+// ParseTypeMap parses type mappings, i.e. the contents of zctypes.txt file.
+// The keys are kinds of types, and the elements are declarations of that
+// kind, e.g.
+//
+//	scalar:
+//	- CFIndex int
+//	object:
+//	- CFStringRef CFString
+//	struct:
+//	- CFRange location int, length int
+//	func:
+//	- CFRunLoopTimerCallBack
+//	memory:
+//	- CFNumberGetValue.valuePtr
+func ParseTypeMap(mappings []Mapping) (*TypeMap, error) {
+	m := &TypeMap{
+		scalars: make(map[string]string),
+		objects: make(map[string]string),
+		structs: make(map[string][]structField),
+		funcs:   make(map[string]bool),
+		memory:  make(map[string]bool),
+	}
+	declared := make(map[string]bool)
+	for _, x := range mappings {
+		for _, s := range x.To {
+			name, decl := s, ""
+			if i := strings.IndexByte(s, ' '); i >= 0 {
+				name, decl = s[:i], strings.TrimSpace(s[i+1:])
+			}
+			if declared[name] {
+				return nil, fmt.Errorf("duplicate type %q", name)
+			}
+			declared[name] = true
+			switch x.From {
+			case "scalar":
+				if !isIdent(name) || !goScalarTypes[decl] {
+					return nil, fmt.Errorf("invalid scalar type %q", s)
+				}
+				m.scalars[name] = decl
+			case "object":
+				if !isIdent(name) || !isIdent(decl) {
+					return nil, fmt.Errorf("invalid object type %q", s)
+				}
+				m.objects[name] = decl
+			case "struct":
+				fields, err := parseStructFields(decl)
+				if !isIdent(name) || err != nil {
+					return nil, fmt.Errorf("invalid structure type %q", s)
+				}
+				m.structs[name] = fields
+			case "func":
+				if !isIdent(name) || decl != "" {
+					return nil, fmt.Errorf("invalid function pointer type %q", s)
+				}
+				m.funcs[name] = true
+			case "memory":
+				xs := strings.Split(name, ".")
+				if len(xs) != 2 || !isIdent(xs[0]) || !isIdent(xs[1]) || decl != "" {
+					return nil, fmt.Errorf("invalid memory parameter %q", s)
+				}
+				m.memory[name] = true
+			default:
+				return nil, fmt.Errorf("unknown kind of types %q", x.From)
+			}
+		}
+	}
+	return m, nil
+}
+
+// parseStructFields parses a comma-separated list of structure fields, e.g.
+// “location int, length int”.
+func parseStructFields(s string) ([]structField, error) {
+	var fields []structField
+	for _, f := range strings.Split(s, ",") {
+		xs := strings.Fields(f)
+		if len(xs) != 2 || !isIdent(xs[0]) || !goScalarTypes[xs[1]] {
+			return nil, fmt.Errorf("invalid structure field %q", f)
+		}
+		if xs[1] == "float32" || xs[1] == "float64" {
+			return nil, fmt.Errorf("unsupported structure field %q", f)
+		}
+		fields = append(fields, structField{Name: xs[0], Type: xs[1]})
+	}
+	if len(fields) > maxIntArgs {
+		return nil, fmt.Errorf("too many structure fields")
+	}
+	return fields, nil
+}
+
+// loadTypeMap loads type mappings from zctypes.txt file. The header h may be
+// nil.
+func loadTypeMap(fsys fs.FS, h *Header) (*TypeMap, error) {
+	mappings, err := loadMappings(fsys, "zctypes.txt")
+	if err != nil {
+		return nil, err
+	}
+	m, err := ParseTypeMap(mappings)
+	if err != nil {
+		return nil, fmt.Errorf("zctypes.txt: %w", err)
+	}
+	m.header = h
+	return m, nil
+}
+
+// goKind is a kind of Go representation for a C type.
+type goKind uint8
+
+const (
+	kindVoid    goKind = iota
+	kindScalar         // Go scalar type
+	kindObject         // internal/types interface
+	kindAddr           // uintptr for unmanaged memory
+	kindCString        // *byte for null-terminated strings in Go memory
+	kindBytes          // []byte for byte buffers in Go memory
+	kindPointer        // unsafe.Pointer for other Go memory
+	kindStruct         // structure passed as separate scalar fields
+)
+
+// goType describes how a C type is represented in Go code.
+type goType struct {
+	Kind goKind
+	// Name is the Go type name for function parameters.
+	Name string
+	// Scalar is the Go scalar type name (for kindScalar).
+	Scalar string
+	// Nullable is true if nil interface value is allowed (for kindObject).
+	Nullable bool
+	// Fields are the structure fields (for kindStruct).
+	Fields []structField
+}
+
+// resolveType returns Go representation for the given C type. Type names that
+// are not in m are resolved using typedefs in the header, if any.
+func resolveType(t Type, m *TypeMap) (goType, error) {
+	if m == nil {
+		m = &TypeMap{}
+	}
+	switch t.Pointers {
+	case 0:
+		if t.Name == "void" {
+			return goType{Kind: kindVoid}, nil
+		}
+		if s, ok := scalarTypes[t.Name]; ok {
+			return goType{Kind: kindScalar, Name: s, Scalar: s}, nil
+		}
+		if s, ok := m.scalars[t.Name]; ok {
+			return goType{Kind: kindScalar, Name: s, Scalar: s}, nil
+		}
+		if s, ok := m.objects[t.Name]; ok {
+			return goType{Kind: kindObject, Name: "types." + s, Nullable: t.Nullable}, nil
+		}
+		if fields, ok := m.structs[t.Name]; ok {
+			return goType{Kind: kindStruct, Fields: fields}, nil
+		}
+		if m.funcs[t.Name] {
+			return goType{Kind: kindAddr, Name: "uintptr"}, nil
+		}
+		if m.header != nil {
+			if td, ok := m.header.Typedefs[t.Name]; ok {
+				return resolveTypedef(t, td, m)
+			}
+		}
+		return goType{}, fmt.Errorf("unknown C type %q", t.Name)
+	case 1:
+		switch t.Name {
+		case "void":
+			return goType{Kind: kindAddr, Name: "uintptr"}, nil
+		case "char":
+			return goType{Kind: kindCString, Name: "*byte"}, nil
+		case "UInt8", "uint8_t", "unsigned char":
+			return goType{Kind: kindBytes, Name: "[]byte"}, nil
+		}
+	}
+	return goType{Kind: kindPointer, Name: "unsafe.Pointer"}, nil
+}
+
+// resolveParam returns Go representation for the i-th parameter of p.
+func resolveParam(p *Proto, i int, m *TypeMap) (goType, error) {
+	param := p.Params[i]
+	if param.Type.Name == "void" && param.Type.Pointers == 1 && m != nil && m.memory[p.Name+"."+param.Name] {
+		return goType{Kind: kindPointer, Name: "unsafe.Pointer"}, nil
+	}
+	return resolveType(param.Type, m)
+}
+
+// resolveTypedef returns Go representation for the type t defined by td.
+func resolveTypedef(t Type, td *Typedef, m *TypeMap) (goType, error) {
+	switch {
+	case td.Func:
+		return goType{Kind: kindAddr, Name: "uintptr"}, nil
+	case td.Struct && td.Type.Pointers == 1:
+		// Opaque reference, e.g. “typedef const struct __CFNumber *CFNumberRef”.
+		name := strings.TrimSuffix(td.Name, "Ref")
+		if name == td.Name {
+			return goType{}, fmt.Errorf("unknown C reference type %q", td.Name)
+		}
+		return goType{Kind: kindObject, Name: "types." + name, Nullable: t.Nullable}, nil
+	case td.Struct:
+		return goType{}, fmt.Errorf("unsupported C type %q", td.Name)
+	}
+	u := td.Type
+	if u.Name == t.Name {
+		return goType{}, fmt.Errorf("recursive C typedef %q", td.Name)
+	}
+	u.Nullable = u.Nullable || t.Nullable
+	return resolveType(u, m)
+}
+
+// cabiName returns the name suffix of cabi Arg and Out constructors for the
+// given Go scalar type.
+func cabiName(scalar string) string {
+	return strings.ToUpper(scalar[:1]) + scalar[1:]
+}
+
+// resultType returns the Go result type and cabi output constructor for the
+// given C type.
+func resultType(t Type, m *TypeMap) (name, out string, err error) {
+	g, err := resolveType(t, m)
+	if err != nil {
+		return "", "", err
+	}
+	switch g.Kind {
+	case kindVoid:
+		return "", "", nil
+	case kindScalar:
+		return g.Scalar, "cabi.Out" + cabiName(g.Scalar), nil
+	case kindObject:
+		return "types.Pointer", "cabi.OutUintptr", nil
+	case kindStruct:
+		return "", "", fmt.Errorf("unsupported result type %q", t)
+	default:
+		return "uintptr", "cabi.OutUintptr", nil
+	}
+}
+
+// CallSignature returns names of cabi package constructors that generated
+// wrappers use for the result and the arguments of the C function, e.g.
+// “OutUintptr” and “Int32”. The result constructor is “Void” for functions
+// without result. Structures passed by value, e.g. CFRange, expand to one
+// argument per field. The type map m may be nil.
+func CallSignature(p *Proto, m *TypeMap) (out string, args []string, err error) {
+	_, out, err = resultType(p.Result, m)
+	if err != nil {
+		return "", nil, err
+	}
+	out = strings.TrimPrefix(out, "cabi.")
+	if out == "" {
+		out = "Void"
+	}
+	for i := range p.Params {
+		t, err := resolveParam(p, i, m)
+		if err != nil {
+			return "", nil, err
+		}
+		if t.Kind == kindStruct {
+			for _, f := range t.Fields {
+				args = append(args, cabiName(f.Type))
+			}
+			continue
+		}
+		args = append(args, cabiArg(t))
+	}
+	return out, args, nil
+}
+
+// cabiArg returns the name of cabi Arg constructor for the Go representation
+// of a C type.
+func cabiArg(t goType) string {
+	switch t.Kind {
+	case kindScalar:
+		return cabiName(t.Scalar)
+	case kindObject, kindAddr:
+		return "Uintptr"
+	case kindBytes:
+		return "Bytes"
+	default:
+		return "UnsafePointer"
+	}
+}
+
+// GoFuncName returns the name of the Go wrapper function for the given C
+// function name, e.g. cfDataGetLength for CFDataGetLength.
+func GoFuncName(name string) string {
+	n := 0
+	for n < len(name) && 'A' <= name[n] && name[n] <= 'Z' {
+		n++
+	}
+	switch {
+	case n == 0:
+		return name
+	case n == len(name):
+		return strings.ToLower(name)
+	case n > 1 && 'a' <= name[n] && name[n] <= 'z':
+		// Keep the first letter of the next word in upper case.
+		n--
+	}
+	return strings.ToLower(name[:n]) + name[n:]
+}
+
+// goKeywords are reserved Go identifiers that cannot be used as parameter
+// names. It also includes names of local variables in generated functions.
+var goKeywords = map[string]bool{
+	"break": true, "case": true, "chan": true, "const": true,
+	"continue": true, "default": true, "defer": true, "else": true,
+	"fallthrough": true, "for": true, "func": true, "go": true,
+	"goto": true, "if": true, "import": true, "interface": true,
+	"map": true, "package": true, "range": true, "return": true,
+	"select": true, "struct": true, "switch": true, "type": true,
+	"var": true,
+
+	// Local names in generated code.
+	"out": true, "fn": true, "cabi": true, "dyld": true, "types": true,
+	"unsafe": true,
+}
+
+// goParamName returns Go parameter name for the i-th C function parameter.
+func goParamName(p Param, i int) string {
+	name := p.Name
+	if name == "" {
+		return fmt.Sprintf("a%d", i)
+	}
+	if goKeywords[name] {
+		name += "_"
+	}
+	return name
+}
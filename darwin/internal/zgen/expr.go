@@ -0,0 +1,266 @@
+package zgen
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// binaryPrecedence is the precedence of C binary operators supported in
+// constant expressions.
+var binaryPrecedence = map[string]int{
+	"||": 1,
+	"&&": 2,
+	"|":  3,
+	"^":  4,
+	"&":  5,
+	"==": 6, "!=": 6,
+	"<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8,
+	"+": 9, "-": 9,
+	"*": 10, "/": 10, "%": 10,
+}
+
+// exprParser evaluates C integer constant expressions, e.g. enumerator values
+// and preprocessor conditions.
+type exprParser struct {
+	toks []string
+	pos  int
+	// lookup returns the value of the identifier. If it is nil, all
+	// identifiers are errors.
+	lookup func(name string) (int64, error)
+	// cpp enables preprocessor mode where defined operator is supported,
+	// and unknown function-like macro calls evaluate to zero.
+	cpp bool
+}
+
+// evalExpr evaluates constant expression tokens.
+func evalExpr(toks []string, lookup func(string) (int64, error), cpp bool) (int64, error) {
+	p := &exprParser{toks: toks, lookup: lookup, cpp: cpp}
+	v, err := p.parseBinary(1)
+	if err != nil {
+		return 0, err
+	}
+	if p.pos != len(p.toks) {
+		return 0, fmt.Errorf("unexpected %q in expression", p.toks[p.pos])
+	}
+	return v, nil
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return ""
+}
+
+func (p *exprParser) next() string {
+	tok := p.peek()
+	if tok != "" {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *exprParser) parseBinary(prec int) (int64, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		op := p.peek()
+		opPrec, ok := binaryPrecedence[op]
+		if !ok || opPrec < prec {
+			return x, nil
+		}
+		p.next()
+		y, err := p.parseBinary(opPrec + 1)
+		if err != nil {
+			return 0, err
+		}
+		if x, err = applyBinary(op, x, y); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func applyBinary(op string, x, y int64) (int64, error) {
+	switch op {
+	case "||":
+		return boolInt(x != 0 || y != 0), nil
+	case "&&":
+		return boolInt(x != 0 && y != 0), nil
+	case "|":
+		return x | y, nil
+	case "^":
+		return x ^ y, nil
+	case "&":
+		return x & y, nil
+	case "==":
+		return boolInt(x == y), nil
+	case "!=":
+		return boolInt(x != y), nil
+	case "<":
+		return boolInt(x < y), nil
+	case ">":
+		return boolInt(x > y), nil
+	case "<=":
+		return boolInt(x <= y), nil
+	case ">=":
+		return boolInt(x >= y), nil
+	case "<<":
+		return int64(uint64(x) << uint64(y)), nil
+	case ">>":
+		return int64(uint64(x) >> uint64(y)), nil
+	case "+":
+		return x + y, nil
+	case "-":
+		return x - y, nil
+	case "*":
+		return x * y, nil
+	case "/", "%":
+		if y == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		if op == "/" {
+			return x / y, nil
+		}
+		return x % y, nil
+	}
+	return 0, fmt.Errorf("unsupported operator %q", op)
+}
+
+func (p *exprParser) parseUnary() (int64, error) {
+	switch op := p.peek(); op {
+	case "-", "+", "~", "!":
+		p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return 0, err
+		}
+		switch op {
+		case "-":
+			return -x, nil
+		case "~":
+			return ^x, nil
+		case "!":
+			return boolInt(x == 0), nil
+		}
+		return x, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (int64, error) {
+	tok := p.next()
+	switch {
+	case tok == "":
+		return 0, fmt.Errorf("unexpected end of expression")
+	case tok == "(":
+		x, err := p.parseBinary(1)
+		if err != nil {
+			return 0, err
+		}
+		if p.next() != ")" {
+			return 0, fmt.Errorf("missing closing parenthesis")
+		}
+		return x, nil
+	case isNumber(tok):
+		return parseNumber(tok)
+	case isCharLiteral(tok):
+		return parseCharLiteral(tok)
+	case p.cpp && tok == "defined":
+		paren := p.peek() == "("
+		if paren {
+			p.next()
+		}
+		name := p.next()
+		if !isIdent(name) {
+			return 0, fmt.Errorf("invalid macro name %q", name)
+		}
+		if paren && p.next() != ")" {
+			return 0, fmt.Errorf("missing closing parenthesis")
+		}
+		_, err := p.lookup(name)
+		return boolInt(err == nil), nil
+	case isIdent(tok):
+		if p.cpp && p.peek() == "(" {
+			// Function-like macros, e.g. __has_feature(x), are not
+			// expanded.
+			p.pos = skipGroup(p.toks, p.pos)
+			return 0, nil
+		}
+		if p.lookup == nil {
+			return 0, fmt.Errorf("undefined identifier %q", tok)
+		}
+		x, err := p.lookup(tok)
+		if err != nil && p.cpp {
+			// Undefined identifiers evaluate to zero in preprocessor
+			// conditions.
+			return 0, nil
+		}
+		return x, err
+	}
+	return 0, fmt.Errorf("unexpected %q in expression", tok)
+}
+
+// skipGroup returns the position after the balanced parenthesized group that
+// starts at toks[i].
+func skipGroup(toks []string, i int) int {
+	depth := 0
+	for ; i < len(toks); i++ {
+		switch toks[i] {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return i
+}
+
+func isNumber(s string) bool {
+	return s != "" && '0' <= s[0] && s[0] <= '9'
+}
+
+// parseNumber parses C integer literal with optional suffixes.
+func parseNumber(s string) (int64, error) {
+	n := strings.TrimRight(s, "uUlL")
+	if len(n) > 1 && n[0] == '0' && n[1] >= '0' && n[1] <= '7' {
+		// C octal literal, e.g. 0755.
+		n = "0o" + n[1:]
+	}
+	v, err := strconv.ParseUint(n, 0, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid integer literal %q", s)
+	}
+	return int64(v), nil
+}
+
+func isCharLiteral(s string) bool {
+	return len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\''
+}
+
+// parseCharLiteral parses C character literal including multi-character
+// constants, e.g. 'abcd', that are commonly used for four-char codes.
+func parseCharLiteral(s string) (int64, error) {
+	body, err := strconv.Unquote(`"` + s[1:len(s)-1] + `"`)
+	if err != nil || body == "" || len(body) > 8 {
+		return 0, fmt.Errorf("invalid character literal %s", s)
+	}
+	var v int64
+	for i := 0; i < len(body); i++ {
+		v = v<<8 | int64(body[i])
+	}
+	return v, nil
+}
+
+func boolInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
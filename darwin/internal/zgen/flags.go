@@ -0,0 +1,353 @@
+package zgen
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// flagsKind is a kind of the generated type.
+type flagsKind uint8
+
+const (
+	// kindOptions is a bit set where each constant is a single bit or a
+	// mask of bits. String representation is a list of names separated by
+	// vertical bar.
+	kindOptions flagsKind = iota
+	// kindEnum is an enumeration where each constant is a distinct value.
+	kindEnum
+)
+
+// flagsType is a flags or enum type declared in zflags.txt.
+type flagsType struct {
+	Name       string
+	Underlying string
+	Kind       flagsKind
+	Prefix     string
+	Doc        string
+	Values     []flagsValue
+}
+
+// flagsValue is a named constant of flagsType.
+type flagsValue struct {
+	Name  string // without prefix
+	Value string // Go constant expression
+	Doc   string
+	// Alias is true if the value is a name of another constant. Aliases
+	// are accepted by the parser but never used in string representation.
+	Alias bool
+	// Enumerator is the name of C enumerator that the value is imported
+	// from, if any.
+	Enumerator string
+}
+
+// flagsBitSize maps Go integer types to strconv bit sizes.
+var flagsBitSize = map[string]int{
+	"int": 0, "int8": 8, "int16": 16, "int32": 32, "int64": 64,
+	"uint": 0, "uint8": 8, "uint16": 16, "uint32": 32, "uint64": 64,
+	"uintptr": 64,
+}
+
+// parseFlags parses zflags.txt mappings. Keys have the following syntax
+//
+//	Type underlying options|enum Prefix
+//
+// and elements are
+//
+//	Name = value
+//
+// where value is a Go constant expression, a name of the previous element or,
+// if h is not nil, a name of C enumerator declared in headers. The constant
+// name is the prefix followed by the element name, and the element name alone
+// is used in the string representation.
+func parseFlags(mappings []Mapping, h *Header) ([]flagsType, error) {
+	types := make([]flagsType, 0, len(mappings))
+	for _, m := range mappings {
+		fields := strings.Fields(m.From)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid flags declaration %q", m.From)
+		}
+		t := flagsType{
+			Name:       fields[0],
+			Underlying: fields[1],
+			Prefix:     fields[3],
+			Doc:        m.Doc,
+		}
+		if !isIdent(t.Name) || !isIdent(t.Prefix) {
+			return nil, fmt.Errorf("invalid flags declaration %q", m.From)
+		}
+		if _, ok := flagsBitSize[t.Underlying]; !ok {
+			return nil, fmt.Errorf("%s: unsupported underlying type %q", t.Name, t.Underlying)
+		}
+		switch fields[2] {
+		case "options":
+			t.Kind = kindOptions
+		case "enum":
+			t.Kind = kindEnum
+		default:
+			return nil, fmt.Errorf("%s: unknown kind %q", t.Name, fields[2])
+		}
+		seen := make(map[string]bool)
+		for i, s := range m.To {
+			name, value, found := cut(s, "=")
+			name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+			if !found || !isIdent(name) || value == "" {
+				return nil, fmt.Errorf("%s: invalid value %q", t.Name, s)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("%s: duplicate value %q", t.Name, name)
+			}
+			seen[name] = true
+			v := flagsValue{
+				Name:  name,
+				Value: value,
+				Doc:   m.ToDocs[i],
+			}
+			switch {
+			case seen[value]:
+				v.Value = t.Prefix + value
+				v.Alias = true
+			case h != nil && isIdent(value):
+				c, ok := h.enumerator(value)
+				if !ok {
+					break
+				}
+				v.Value = strconv.FormatInt(c.Value, 10)
+				if t.Kind == kindOptions && c.Value > 0 {
+					v.Value = "0x" + strconv.FormatInt(c.Value, 16)
+				}
+				v.Enumerator = value
+			}
+			t.Values = append(t.Values, v)
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// cut is strings.Cut for Go 1.17.
+func cut(s, sep string) (before, after string, found bool) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
+// genGoFlags generates flags and enum types. The generated file does not have
+// build constraints so that it can be tested on any platform.
+func genGoFlags(c *Config, types []flagsType) []byte {
+	g := bytes.NewBuffer(nil)
+	fmt.Fprintln(g, codegenHeader)
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, `package %s`+"\n", c.Package)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `import (`)
+	fmt.Fprintln(g, "\t"+`"fmt"`)
+	fmt.Fprintln(g, "\t"+`"strconv"`)
+	fmt.Fprintln(g, "\t"+`"strings"`)
+	fmt.Fprintln(g, `)`)
+	for _, t := range types {
+		genGoFlagsType(g, t)
+	}
+	return g.Bytes()
+}
+
+func genGoFlagsType(g *bytes.Buffer, t flagsType) {
+	fmt.Fprintln(g)
+	if t.Doc != "" {
+		fmt.Fprintln(g, t.Doc)
+	}
+	fmt.Fprintf(g, "type %s %s\n", t.Name, t.Underlying)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, "const (")
+	for i, v := range t.Values {
+		if i > 0 && (v.Doc != "" || t.Values[i-1].Doc != "") {
+			fmt.Fprintln(g)
+		}
+		if v.Doc != "" {
+			for _, line := range strings.Split(v.Doc, "\n") {
+				fmt.Fprintln(g, "\t"+line)
+			}
+		}
+		fmt.Fprintf(g, "\t%s%s %s = %s", t.Prefix, v.Name, t.Name, v.Value)
+		if v.Enumerator != "" {
+			fmt.Fprintf(g, " // %s", v.Enumerator)
+		}
+		fmt.Fprintln(g)
+	}
+	fmt.Fprintln(g, ")")
+
+	switch t.Kind {
+	case kindOptions:
+		genGoOptionsMethods(g, t)
+	case kindEnum:
+		genGoEnumMethods(g, t)
+	}
+
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// MarshalText implements the encoding.TextMarshaler interface.`)
+	fmt.Fprintf(g, "func (k %s) MarshalText() ([]byte, error) {\n", t.Name)
+	fmt.Fprintln(g, "\treturn []byte(k.String()), nil")
+	fmt.Fprintln(g, "}")
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// UnmarshalText implements the encoding.TextUnmarshaler interface.`)
+	fmt.Fprintf(g, "func (k *%s) UnmarshalText(b []byte) error {\n", t.Name)
+	fmt.Fprintf(g, "\tv, err := Parse%s(string(b))\n", t.Name)
+	fmt.Fprintln(g, "\tif err != nil {")
+	fmt.Fprintln(g, "\t\treturn err")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintln(g, "\t*k = v")
+	fmt.Fprintln(g, "\treturn nil")
+	fmt.Fprintln(g, "}")
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// Set implements the flag.Value interface.`)
+	fmt.Fprintf(g, "func (k *%s) Set(s string) error {\n", t.Name)
+	fmt.Fprintln(g, "\treturn k.UnmarshalText([]byte(s))")
+	fmt.Fprintln(g, "}")
+}
+
+func genGoOptionsMethods(g *bytes.Buffer, t flagsType) {
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// String implements the fmt.Stringer interface.`)
+	fmt.Fprintf(g, "func (k %s) String() string {\n", t.Name)
+	fmt.Fprintln(g, "\tif name, ok := k.bitString(); ok {")
+	fmt.Fprintln(g, "\t\treturn name")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintln(g, "\tvar names []string")
+	fmt.Fprintf(g, "\tfor i := %s(1); i != 0 && i <= k; i <<= 1 {\n", t.Name)
+	fmt.Fprintln(g, "\t\tif k&i == 0 {")
+	fmt.Fprintln(g, "\t\t\tcontinue")
+	fmt.Fprintln(g, "\t\t}")
+	fmt.Fprintln(g, "\t\tname, ok := i.bitString()")
+	fmt.Fprintln(g, "\t\tif !ok {")
+	fmt.Fprintln(g, "\t\t\tcontinue")
+	fmt.Fprintln(g, "\t\t}")
+	fmt.Fprintln(g, "\t\tk ^= i")
+	fmt.Fprintln(g, "\t\tnames = append(names, name)")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintln(g, "\tif k != 0 {")
+	fmt.Fprintf(g, "\t\tnames = append(names, fmt.Sprintf(\"%%b\", k))\n")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintln(g, "\treturn strings.Join(names, \"|\")")
+	fmt.Fprintln(g, "}")
+
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, "func (k %s) bitString() (string, bool) {\n", t.Name)
+	genGoFlagsNameSwitch(g, t)
+	fmt.Fprintln(g, "}")
+
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, "// Parse%[1]s parses %[1]s from the string representation, i.e. names\n", t.Name)
+	fmt.Fprintf(g, "// separated by vertical bar, e.g. “%s”. Bits without a name are\n", exampleOptions(t))
+	fmt.Fprintln(g, "// represented in binary.")
+	fmt.Fprintf(g, "func Parse%[1]s(s string) (%[1]s, error) {\n", t.Name)
+	fmt.Fprintf(g, "\tvar k %s\n", t.Name)
+	fmt.Fprintln(g, "\tif s == \"\" {")
+	fmt.Fprintln(g, "\t\treturn k, nil")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintln(g, "\tfor _, name := range strings.Split(s, \"|\") {")
+	fmt.Fprintf(g, "\t\tif v, ok := parse%sName(name); ok {\n", t.Name)
+	fmt.Fprintln(g, "\t\t\tk |= v")
+	fmt.Fprintln(g, "\t\t\tcontinue")
+	fmt.Fprintln(g, "\t\t}")
+	fmt.Fprintf(g, "\t\tv, err := strconv.ParseUint(name, 2, %d)\n", flagsBitSize[t.Underlying])
+	fmt.Fprintln(g, "\t\tif err != nil {")
+	fmt.Fprintf(g, "\t\t\treturn 0, fmt.Errorf(\"invalid %s value %%q\", name)\n", t.Name)
+	fmt.Fprintln(g, "\t\t}")
+	fmt.Fprintf(g, "\t\tk |= %s(v)\n", t.Name)
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintln(g, "\treturn k, nil")
+	fmt.Fprintln(g, "}")
+
+	genGoFlagsParseName(g, t)
+}
+
+func genGoEnumMethods(g *bytes.Buffer, t flagsType) {
+	parse, conv := "ParseInt", "int64"
+	if strings.HasPrefix(t.Underlying, "uint") {
+		parse, conv = "ParseUint", "uint64"
+	}
+
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// String implements the fmt.Stringer interface.`)
+	fmt.Fprintf(g, "func (k %s) String() string {\n", t.Name)
+	fmt.Fprintln(g, "\tif name, ok := k.name(); ok {")
+	fmt.Fprintln(g, "\t\treturn name")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintf(g, "\treturn \"%s(\" + strconv.Format%s(%s(k), 10) + \")\"\n", t.Name, strings.TrimPrefix(parse, "Parse"), conv)
+	fmt.Fprintln(g, "}")
+
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, "func (k %s) name() (string, bool) {\n", t.Name)
+	genGoFlagsNameSwitch(g, t)
+	fmt.Fprintln(g, "}")
+
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, "// Parse%[1]s parses %[1]s from the string representation, e.g.\n", t.Name)
+	fmt.Fprintf(g, "// “%s”. Values without a name are represented as “%s(n)”.\n", t.Values[0].Name, t.Name)
+	fmt.Fprintf(g, "func Parse%[1]s(s string) (%[1]s, error) {\n", t.Name)
+	fmt.Fprintf(g, "\tif v, ok := parse%sName(s); ok {\n", t.Name)
+	fmt.Fprintln(g, "\t\treturn v, nil")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintf(g, "\tif n := strings.TrimSuffix(strings.TrimPrefix(s, \"%s(\"), \")\"); len(n) == len(s)-len(\"%s()\") {\n", t.Name, t.Name)
+	fmt.Fprintf(g, "\t\tif v, err := strconv.%s(n, 10, %d); err == nil {\n", parse, flagsBitSize[t.Underlying])
+	fmt.Fprintf(g, "\t\t\treturn %s(v), nil\n", t.Name)
+	fmt.Fprintln(g, "\t\t}")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintf(g, "\treturn 0, fmt.Errorf(\"invalid %s value %%q\", s)\n", t.Name)
+	fmt.Fprintln(g, "}")
+
+	genGoFlagsParseName(g, t)
+}
+
+// genGoFlagsNameSwitch generates a switch statement that returns the name of
+// the constant.
+func genGoFlagsNameSwitch(g *bytes.Buffer, t flagsType) {
+	fmt.Fprintln(g, "\tvar v string")
+	fmt.Fprintln(g, "\tswitch k {")
+	for _, v := range t.Values {
+		if v.Alias {
+			continue
+		}
+		fmt.Fprintf(g, "\tcase %s%s:\n", t.Prefix, v.Name)
+		fmt.Fprintf(g, "\t\tv = %q\n", v.Name)
+	}
+	fmt.Fprintln(g, "\tdefault:")
+	fmt.Fprintln(g, "\t\treturn \"\", false")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintln(g, "\treturn v, true")
+}
+
+// genGoFlagsParseName generates a function that returns the constant for the
+// given name.
+func genGoFlagsParseName(g *bytes.Buffer, t flagsType) {
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, "func parse%[1]sName(s string) (%[1]s, bool) {\n", t.Name)
+	fmt.Fprintf(g, "\tvar v %s\n", t.Name)
+	fmt.Fprintln(g, "\tswitch s {")
+	for _, v := range t.Values {
+		fmt.Fprintf(g, "\tcase %q:\n", v.Name)
+		fmt.Fprintf(g, "\t\tv = %s%s\n", t.Prefix, v.Name)
+	}
+	fmt.Fprintln(g, "\tdefault:")
+	fmt.Fprintln(g, "\t\treturn 0, false")
+	fmt.Fprintln(g, "\t}")
+	fmt.Fprintln(g, "\treturn v, true")
+	fmt.Fprintln(g, "}")
+}
+
+// exampleOptions returns an example string representation for the options.
+func exampleOptions(t flagsType) string {
+	var names []string
+	for _, v := range t.Values {
+		if v.Value == "0" || v.Alias {
+			continue
+		}
+		names = append(names, v.Name)
+		if len(names) == 2 {
+			break
+		}
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,209 @@
+package zgen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// GlobalKind is the kind of a global symbol in zglobals.txt.
+type GlobalKind string
+
+const (
+	// GlobalObject is a global variable that holds a reference to an
+	// object, e.g. kCFAllocatorDefault. The accessor returns the value
+	// stored at the symbol address.
+	GlobalObject GlobalKind = "object"
+	// GlobalData is a data symbol, e.g. kCFTypeArrayCallBacks structure.
+	// The accessor returns the symbol address itself.
+	GlobalData GlobalKind = "data"
+)
+
+// Global is a global symbol declared in zglobals.txt.
+type Global struct {
+	Name string
+	Kind GlobalKind
+	// Weak is true if the symbol may be unavailable at run time.
+	Weak bool
+	// GoName and GoType are the name and result type of the accessor
+	// function. Both are empty if there is no accessor.
+	GoName string
+	GoType string
+	// Doc is the doc comment for the accessor.
+	Doc string
+}
+
+// libraryGlobals is a list of global symbols in a library.
+type libraryGlobals struct {
+	Library string
+	Globals []Global
+}
+
+// parseGlobals parses zglobals.txt mappings. Elements have the following
+// form, where accessor name and type are optional.
+//
+//	[weak] object|data <symbol> [<GoName> <GoType>]
+func parseGlobals(mappings []Mapping) ([]libraryGlobals, error) {
+	xs := make([]libraryGlobals, 0, len(mappings))
+	for _, m := range mappings {
+		lib, overrides, err := parseLibrary(m.From)
+		if err != nil {
+			return nil, err
+		}
+		if l, ok := overrides["darwin"]; ok {
+			// Globals are resolved using dyld, so only darwin library
+			// matters.
+			lib = l
+		}
+		x := libraryGlobals{Library: lib}
+		for i, s := range m.To {
+			s, weak := cutWeak(s)
+			fields := strings.Fields(s)
+			if len(fields) != 2 && len(fields) < 4 {
+				return nil, fmt.Errorf("invalid global %q: expected kind, symbol and optional Go name and type", s)
+			}
+			v := Global{
+				Name: fields[1],
+				Kind: GlobalKind(fields[0]),
+				Weak: weak,
+				Doc:  m.ToDocs[i],
+			}
+			if v.Kind != GlobalObject && v.Kind != GlobalData {
+				return nil, fmt.Errorf("invalid global %q: unknown kind %q", s, v.Kind)
+			}
+			if !isIdent(v.Name) {
+				return nil, fmt.Errorf("invalid global %q: bad symbol name", s)
+			}
+			if len(fields) > 2 {
+				v.GoName = fields[2]
+				v.GoType = strings.Join(fields[3:], " ")
+				if !isIdent(v.GoName) || goKeywords[v.GoName] {
+					return nil, fmt.Errorf("invalid global %q: bad Go name", s)
+				}
+			}
+			x.Globals = append(x.Globals, v)
+		}
+		xs = append(xs, x)
+	}
+	return xs, nil
+}
+
+func genGoGlobals(c *Config, mappings []Mapping) ([]byte, error) {
+	libs, err := parseGlobals(mappings)
+	if err != nil {
+		return nil, err
+	}
+	var accessors, objects bool
+	for _, m := range libs {
+		for _, v := range m.Globals {
+			if v.GoName != "" {
+				accessors = true
+				objects = objects || v.Kind == GlobalObject
+			}
+		}
+	}
+
+	g := bytes.NewBuffer(nil)
+	fmt.Fprintln(g, codegenHeader)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `//go:build darwin`)
+	fmt.Fprintln(g, `// +build darwin`)
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, `package %s`+"\n", c.Package)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `import (`)
+	fmt.Fprintln(g, "\t"+`"sync"`)
+	if accessors {
+		fmt.Fprintln(g, "\t"+`"unsafe"`)
+	}
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, "\t"+`"github.com/noncgo/x/darwin/internal/dyld"`)
+	if objects {
+		fmt.Fprintln(g, "\t"+`"github.com/noncgo/x/darwin/internal/types"`)
+	}
+	fmt.Fprintln(g, `)`)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// Uh, apparently cgo:cgo_import_dynamic links against function call stub?`)
+	fmt.Fprintln(g, `// As a workaround, we use dlsym to get the right address from the loaded image.`)
+	fmt.Fprintln(g)
+	for _, m := range libs {
+		fmt.Fprintf(g, `//go:cgo_import_dynamic _ _ "%s"`+"\n", m.Library)
+	}
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `var globals struct {`)
+	for _, m := range libs {
+		for i, v := range m.Globals {
+			if i > 0 {
+				fmt.Fprintln(g)
+			}
+			fmt.Fprintf(g, "\t"+`%s_addr uintptr`+"\n", v.Name)
+			fmt.Fprintf(g, "\t"+`%s_once sync.Once`+"\n", v.Name)
+		}
+	}
+	fmt.Fprintln(g, `}`)
+	for _, m := range libs {
+		for _, v := range m.Globals {
+			if v.GoName != "" {
+				fmt.Fprintln(g)
+				genGoAccessor(g, v)
+			}
+		}
+	}
+	for _, m := range libs {
+		for _, v := range m.Globals {
+			fmt.Fprintln(g)
+			genGoLookup(g, "globals", v.Name, v.Weak)
+			if v.Weak {
+				fmt.Fprintln(g)
+				fmt.Fprintf(g, `// extern_%[1]s_isAvailable returns true if %[1]s is available at run time.`+"\n", v.Name)
+				fmt.Fprintf(g, `func extern_%s_isAvailable() bool {`+"\n", v.Name)
+				fmt.Fprintf(g, "\t"+`return extern_%s_getAddr() != 0`+"\n", v.Name)
+				fmt.Fprintln(g, `}`)
+			}
+		}
+	}
+	return g.Bytes(), nil
+}
+
+// genGoAccessor generates the accessor function for the global symbol. Object
+// accessors load the reference stored at the symbol address, and the Go type
+// must be an interface implemented by types.Pointer. Data accessors convert
+// the symbol address to the pointer-shaped Go type.
+func genGoAccessor(g *bytes.Buffer, v Global) {
+	if v.Doc != "" {
+		fmt.Fprintln(g, v.Doc)
+	} else if v.Kind == GlobalObject {
+		fmt.Fprintf(g, `// %s returns the value of %s global constant.`+"\n", v.GoName, v.Name)
+	} else {
+		fmt.Fprintf(g, `// %s returns the address of %s data symbol.`+"\n", v.GoName, v.Name)
+	}
+	if v.Weak {
+		if v.Doc != "" {
+			fmt.Fprintln(g, `//`)
+		}
+		fmt.Fprintf(g, `// It returns dyld.ErrUnavailable error if %s is not available at run time.`+"\n", v.Name)
+		fmt.Fprintf(g, `func %s() (v %s, err error) {`+"\n", v.GoName, v.GoType)
+	} else {
+		fmt.Fprintf(g, `func %s() %s {`+"\n", v.GoName, v.GoType)
+	}
+	fmt.Fprintf(g, "\t"+`addr := extern_%s_getAddr()`+"\n", v.Name)
+	if v.Weak {
+		fmt.Fprintln(g, "\t"+`if addr == 0 {`)
+		fmt.Fprintln(g, "\t\t"+`return v, dyld.ErrUnavailable`)
+		fmt.Fprintln(g, "\t"+`}`)
+	}
+	var value string
+	switch v.Kind {
+	case GlobalObject:
+		fmt.Fprintln(g, "\t"+`addr = **(**uintptr)(unsafe.Pointer(&addr))`)
+		value = `types.Pointer(addr)`
+	case GlobalData:
+		value = fmt.Sprintf(`*(*%s)(unsafe.Pointer(&addr))`, v.GoType)
+	}
+	if v.Weak {
+		fmt.Fprintf(g, "\t"+`return %s, nil`+"\n", value)
+	} else {
+		fmt.Fprintf(g, "\t"+`return %s`+"\n", value)
+	}
+	fmt.Fprintln(g, `}`)
+}
@@ -0,0 +1,766 @@
+package zgen
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// Header is a set of C declarations parsed from header files.
+//
+// The parser understands a small subset of C that is used in framework
+// headers: typedefs, enums (including CF_ENUM and CF_OPTIONS), function
+// prototypes and global variables. Preprocessor conditionals are evaluated
+// using object-like macros defined in headers and a few predefined macros for
+// LP64 macOS targets. Declarations outside of the supported subset are
+// skipped.
+type Header struct {
+	// Typedefs maps type names to their definitions.
+	Typedefs map[string]*Typedef
+	// Enums is a list of enum declarations in the order of appearance.
+	Enums []*Enum
+	// Protos maps function names to their prototypes.
+	Protos map[string]*Proto
+	// Globals maps global variable names to their types.
+	Globals map[string]Type
+
+	defines map[string]string
+	consts  map[string]int64
+}
+
+// Typedef is a C type definition.
+type Typedef struct {
+	Name string
+	Type Type
+	// Struct is true if the type refers to a struct or union, e.g.
+	// “typedef const struct __CFData *CFDataRef”.
+	Struct bool
+	// Func is true if the type is a function pointer.
+	Func bool
+}
+
+// Enum is a C enum declaration.
+type Enum struct {
+	// Name is the type name for typedef enums. It is empty for anonymous
+	// enums.
+	Name string
+	// Type is the underlying type name, e.g. “CFIndex” for CF_ENUM. It is
+	// empty if the underlying type is not specified.
+	Type string
+	// Options is true if the enum is declared using CF_OPTIONS macro.
+	Options bool
+	// Constants is a list of enumerators.
+	Constants []EnumConstant
+}
+
+// EnumConstant is a C enumerator.
+type EnumConstant struct {
+	Name  string
+	Value int64
+}
+
+// enumerator returns the enumerator with the given name.
+func (h *Header) enumerator(name string) (EnumConstant, bool) {
+	for _, e := range h.Enums {
+		for _, c := range e.Constants {
+			if c.Name == name {
+				return c, true
+			}
+		}
+	}
+	return EnumConstant{}, false
+}
+
+// predefinedMacros are the compiler-defined macros for LP64 macOS targets.
+var predefinedMacros = map[string]string{
+	"__APPLE__":        "1",
+	"__MACH__":         "1",
+	"__LP64__":         "1",
+	"__BLOCKS__":       "1",
+	"__STDC__":         "1",
+	"TARGET_OS_MAC":    "1",
+	"TARGET_OS_OSX":    "1",
+	"TARGET_RT_64_BIT": "1",
+}
+
+// ignoredMacros are annotations that do not affect declarations. If a macro
+// is followed by a parenthesized argument list, the arguments are skipped as
+// well.
+var ignoredMacros = map[string]bool{
+	"extern":                            true,
+	"static":                            true,
+	"inline":                            true,
+	"__inline":                          true,
+	"__inline__":                        true,
+	"__attribute__":                     true,
+	"__BEGIN_DECLS":                     true,
+	"__END_DECLS":                       true,
+	"CF_EXPORT":                         true,
+	"CF_INLINE":                         true,
+	"CF_EXTERN_C_BEGIN":                 true,
+	"CF_EXTERN_C_END":                   true,
+	"CF_ASSUME_NONNULL_BEGIN":           true,
+	"CF_ASSUME_NONNULL_END":             true,
+	"CF_IMPLICIT_BRIDGING_ENABLED":      true,
+	"CF_IMPLICIT_BRIDGING_DISABLED":     true,
+	"CF_RETURNS_NOT_RETAINED":           true,
+	"CF_CONSUMED":                       true,
+	"CF_NOESCAPE":                       true,
+	"CF_BRIDGED_TYPE":                   true,
+	"CF_BRIDGED_MUTABLE_TYPE":           true,
+	"CF_RELATED_TYPE":                   true,
+	"CF_SWIFT_NAME":                     true,
+	"CF_SWIFT_UNAVAILABLE":              true,
+	"CF_REFINED_FOR_SWIFT":              true,
+	"CF_FORMAT_FUNCTION":                true,
+	"CF_FORMAT_ARGUMENT":                true,
+	"CF_AUTOMATED_REFCOUNT_UNAVAILABLE": true,
+	"NS_SWIFT_NAME":                     true,
+	"NS_REFINED_FOR_SWIFT":              true,
+}
+
+// availabilityPrefixes are name prefixes of availability macros.
+var availabilityPrefixes = []string{
+	"API_",
+	"__API_",
+	"__OSX_",
+	"__IOS_",
+	"__TVOS_",
+	"__WATCHOS_",
+	"CF_AVAILABLE",
+	"CF_DEPRECATED",
+	"CF_ENUM_AVAILABLE",
+	"CF_ENUM_DEPRECATED",
+	"AVAILABLE_MAC_OS_X_",
+	"DEPRECATED_IN_MAC_OS_X_",
+	"DEPRECATED_ATTRIBUTE",
+}
+
+func isAvailabilityMacro(name string) bool {
+	for _, prefix := range availabilityPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewHeader returns an empty set of declarations.
+func NewHeader() *Header {
+	h := &Header{
+		Typedefs: make(map[string]*Typedef),
+		Protos:   make(map[string]*Proto),
+		Globals:  make(map[string]Type),
+		defines:  make(map[string]string),
+		consts:   make(map[string]int64),
+	}
+	for k, v := range predefinedMacros {
+		h.defines[k] = v
+	}
+	return h
+}
+
+// Parse parses header file source and adds declarations to h. Declarations
+// from previously parsed files are visible to the later ones, so headers must
+// be parsed in the inclusion order.
+func (h *Header) Parse(name string, src []byte) error {
+	lines, err := h.preprocess(string(src))
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	toks, err := lexC(strings.Join(lines, "\n"))
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	for _, decl := range splitDecls(toks) {
+		// Declarations outside of the supported subset are skipped.
+		// Lookups for them fail later with a more helpful error.
+		_ = h.parseDecl(decl)
+	}
+	return nil
+}
+
+// loadHeaders parses headers listed in zheaders.txt mapping file. The keys are
+// directories relative to the SDK root and elements are header file names. It
+// returns nil if the file does not exist.
+func loadHeaders(fsys, sdk fs.FS) (*Header, error) {
+	mappings, err := loadMappings(fsys, "zheaders.txt")
+	if err != nil || mappings == nil {
+		return nil, err
+	}
+	if sdk == nil {
+		return nil, fmt.Errorf("zheaders.txt: SDK path is not set")
+	}
+	h := NewHeader()
+	for _, m := range mappings {
+		for _, name := range m.To {
+			p := path.Join(strings.TrimPrefix(m.From, "/"), name)
+			src, err := fs.ReadFile(sdk, p)
+			if err != nil {
+				return nil, fmt.Errorf("zheaders.txt: %w", err)
+			}
+			if err := h.Parse(p, src); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return h, nil
+}
+
+// condFrame is a state of the preprocessor conditional.
+type condFrame struct {
+	parent bool // parent block is active
+	active bool // current branch is active
+	taken  bool // some branch was already taken
+}
+
+// preprocess removes comments, joins continuation lines and evaluates
+// preprocessor directives. It returns the lines in active conditional blocks.
+func (h *Header) preprocess(src string) ([]string, error) {
+	src = stripComments(src)
+	src = strings.ReplaceAll(src, "\\\r\n", "")
+	src = strings.ReplaceAll(src, "\\\n", "")
+
+	var out []string
+	var stack []condFrame
+	active := true
+	for _, line := range strings.Split(src, "\n") {
+		s := strings.TrimSpace(line)
+		if !strings.HasPrefix(s, "#") {
+			if active {
+				out = append(out, line)
+			}
+			continue
+		}
+		s = strings.TrimSpace(s[1:])
+		directive := s
+		rest := ""
+		if i := strings.IndexAny(s, " \t("); i >= 0 {
+			directive, rest = s[:i], strings.TrimSpace(s[i:])
+		}
+		switch directive {
+		case "if", "ifdef", "ifndef":
+			f := condFrame{parent: active}
+			if active {
+				v, err := h.evalCondition(directive, rest)
+				if err != nil {
+					return nil, err
+				}
+				f.active, f.taken = v, v
+			}
+			stack = append(stack, f)
+			active = f.active
+		case "elif", "else":
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("#%s without #if", directive)
+			}
+			f := &stack[len(stack)-1]
+			f.active = false
+			if f.parent && !f.taken {
+				v := true
+				if directive == "elif" {
+					var err error
+					if v, err = h.evalCondition("if", rest); err != nil {
+						return nil, err
+					}
+				}
+				f.active, f.taken = v, v
+			}
+			active = f.active
+		case "endif":
+			if len(stack) == 0 {
+				return nil, fmt.Errorf("#endif without #if")
+			}
+			active = stack[len(stack)-1].parent
+			stack = stack[:len(stack)-1]
+		case "define":
+			if active {
+				h.define(rest)
+			}
+		case "undef":
+			if active {
+				delete(h.defines, rest)
+			}
+		}
+	}
+	if len(stack) != 0 {
+		return nil, fmt.Errorf("unterminated #if")
+	}
+	return out, nil
+}
+
+// define records object-like macro definition. Function-like macros are
+// ignored.
+func (h *Header) define(s string) {
+	i := 0
+	for i < len(s) && isIdentByte(s[i], i > 0) {
+		i++
+	}
+	name := s[:i]
+	if name == "" || i < len(s) && s[i] == '(' {
+		return
+	}
+	h.defines[name] = strings.TrimSpace(s[i:])
+}
+
+// evalCondition evaluates the condition of #if, #ifdef or #ifndef directive.
+func (h *Header) evalCondition(directive, cond string) (bool, error) {
+	switch directive {
+	case "ifdef", "ifndef":
+		fields := strings.Fields(cond)
+		if len(fields) == 0 {
+			return false, fmt.Errorf("#%s without macro name", directive)
+		}
+		_, ok := h.defines[fields[0]]
+		return ok == (directive == "ifdef"), nil
+	}
+	toks, err := lexC(cond)
+	if err != nil {
+		return false, err
+	}
+	v, err := evalExpr(toks, h.macroValue(0), true)
+	if err != nil {
+		return false, fmt.Errorf("#if %s: %w", cond, err)
+	}
+	return v != 0, nil
+}
+
+// macroValue returns a lookup function that evaluates object-like macros.
+func (h *Header) macroValue(depth int) func(string) (int64, error) {
+	return func(name string) (int64, error) {
+		def, ok := h.defines[name]
+		if !ok {
+			return 0, fmt.Errorf("undefined macro %q", name)
+		}
+		if def == "" || depth > 16 {
+			return 0, nil
+		}
+		toks, err := lexC(def)
+		if err != nil {
+			return 0, err
+		}
+		return evalExpr(toks, h.macroValue(depth+1), true)
+	}
+}
+
+// constValue looks up the value of enumerator or object-like macro.
+func (h *Header) constValue(name string) (int64, error) {
+	if v, ok := h.consts[name]; ok {
+		return v, nil
+	}
+	def, ok := h.defines[name]
+	if !ok {
+		return 0, fmt.Errorf("undefined identifier %q", name)
+	}
+	toks, err := lexC(def)
+	if err != nil {
+		return 0, err
+	}
+	return evalExpr(toks, h.constValue, false)
+}
+
+// stripComments replaces C comments with spaces.
+func stripComments(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(s) && s[j] != c && s[j] != '\n' {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				j = len(s) - 1
+			}
+			b.WriteString(s[i : j+1])
+			i = j
+		case strings.HasPrefix(s[i:], "//"):
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+			b.WriteByte('\n')
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				end = len(s) - i - 4
+			}
+			// Keep newlines so that directives stay on their own lines.
+			b.WriteString(strings.Repeat("\n", strings.Count(s[i:i+2+end], "\n")))
+			b.WriteByte(' ')
+			i += end + 3
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// punctuators are multi-character C punctuators.
+var punctuators = []string{"...", "<<", ">>", "&&", "||", "==", "!=", "<=", ">=", "->", "##"}
+
+// lexC splits C source into tokens.
+func lexC(s string) ([]string, error) {
+	var toks []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v':
+			i++
+		case isIdentByte(c, true):
+			j := i
+			for j < len(s) && (isIdentByte(s[j], true) || s[j] == '.' && isNumber(s[i:])) {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(s) && s[j] != c {
+				if s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("unterminated literal %s", s[i:])
+			}
+			toks = append(toks, s[i:j+1])
+			i = j + 1
+		case c < ' ' || c > '~':
+			return nil, fmt.Errorf("unexpected character %q", c)
+		default:
+			tok := s[i : i+1]
+			for _, p := range punctuators {
+				if strings.HasPrefix(s[i:], p) {
+					tok = p
+					break
+				}
+			}
+			toks = append(toks, tok)
+			i += len(tok)
+		}
+	}
+	return toks, nil
+}
+
+func isIdentByte(c byte, digit bool) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || digit && '0' <= c && c <= '9'
+}
+
+// splitDecls splits tokens into top-level declarations. Function definitions,
+// e.g. CF_INLINE functions, are dropped.
+func splitDecls(toks []string) [][]string {
+	var decls [][]string
+	var decl []string
+	depth := 0
+	for i := 0; i < len(toks); i++ {
+		tok := toks[i]
+		switch tok {
+		case "(", "[":
+			depth++
+		case ")", "]":
+			depth--
+		case "{":
+			if depth == 0 && len(decl) > 0 {
+				switch prev := decl[len(decl)-1]; {
+				case strings.HasPrefix(prev, `"`):
+					// extern "C" { ... }
+					decl = nil
+					continue
+				case prev == ")" && !isTypeDecl(decl):
+					// Function definition.
+					i = skipBraces(toks, i)
+					decl = nil
+					continue
+				}
+			}
+			depth++
+		case "}":
+			if depth == 0 {
+				// Closing brace of extern "C" block.
+				continue
+			}
+			depth--
+		case ";":
+			if depth == 0 {
+				if len(decl) > 0 {
+					decls = append(decls, decl)
+				}
+				decl = nil
+				continue
+			}
+		}
+		decl = append(decl, tok)
+	}
+	return decls
+}
+
+// isTypeDecl returns true if the declaration defines a type with a body.
+func isTypeDecl(decl []string) bool {
+	for _, tok := range decl {
+		switch tok {
+		case "typedef", "enum", "struct", "union", "CF_ENUM", "CF_OPTIONS", "CF_CLOSED_ENUM":
+			return true
+		}
+	}
+	return false
+}
+
+// skipBraces returns the index of the closing brace that matches the opening
+// brace at toks[i].
+func skipBraces(toks []string, i int) int {
+	depth := 0
+	for ; i < len(toks); i++ {
+		switch toks[i] {
+		case "{":
+			depth++
+		case "}":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return i
+}
+
+// declAttrs are attributes of the declaration specified using macros.
+type declAttrs struct {
+	Retained     bool
+	Availability []string
+}
+
+// stripMacros removes annotation macros from the declaration.
+func stripMacros(decl []string) ([]string, declAttrs) {
+	var attrs declAttrs
+	out := make([]string, 0, len(decl))
+	for i := 0; i < len(decl); i++ {
+		tok := decl[i]
+		availability := isAvailabilityMacro(tok)
+		if tok != "CF_RETURNS_RETAINED" && !availability && !ignoredMacros[tok] {
+			out = append(out, tok)
+			continue
+		}
+		end := i + 1
+		if end < len(decl) && decl[end] == "(" {
+			end = skipGroup(decl, end)
+		}
+		switch {
+		case tok == "CF_RETURNS_RETAINED":
+			attrs.Retained = true
+		case availability:
+			attrs.Availability = append(attrs.Availability, joinTokens(decl[i:end]))
+		}
+		i = end - 1
+	}
+	return out, attrs
+}
+
+// joinTokens formats tokens as C source.
+func joinTokens(toks []string) string {
+	var b strings.Builder
+	for i, tok := range toks {
+		if i > 0 && isIdentByte(tok[0], true) && isIdentByte(toks[i-1][len(toks[i-1])-1], true) {
+			b.WriteByte(' ')
+		}
+		if tok == "," {
+			b.WriteString(", ")
+			continue
+		}
+		b.WriteString(tok)
+	}
+	return b.String()
+}
+
+// parseDecl parses a top-level declaration.
+func (h *Header) parseDecl(decl []string) error {
+	toks, attrs := stripMacros(decl)
+	if len(toks) == 0 {
+		return nil
+	}
+	switch toks[0] {
+	case "typedef":
+		return h.parseTypedef(toks[1:])
+	case "enum", "CF_ENUM", "CF_OPTIONS", "CF_CLOSED_ENUM":
+		_, err := h.parseEnum(toks)
+		return err
+	case "struct", "union":
+		if indexToken(toks, "{") >= 0 {
+			return nil
+		}
+	}
+	for _, tok := range toks {
+		switch tok {
+		case "{", "[", "...", "^", "=":
+			return fmt.Errorf("unsupported declaration %q", joinTokens(toks))
+		}
+	}
+	if indexToken(toks, "(") >= 0 {
+		p, err := ParseProto(joinTokens(toks))
+		if err != nil {
+			return err
+		}
+		p.Retained = attrs.Retained
+		p.Availability = strings.Join(attrs.Availability, " ")
+		h.Protos[p.Name] = p
+		return nil
+	}
+	t, name, err := parseDecl(toks, true)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return fmt.Errorf("missing variable name in %q", joinTokens(toks))
+	}
+	h.Globals[name] = t
+	return nil
+}
+
+// parseTypedef parses typedef declaration without the typedef keyword.
+func (h *Header) parseTypedef(toks []string) error {
+	if len(toks) == 0 {
+		return fmt.Errorf("empty typedef")
+	}
+	if e, err := h.parseEnum(toks); err != nil || e != nil {
+		if err != nil {
+			return err
+		}
+		if e.Name == "" {
+			return fmt.Errorf("missing typedef name for enum")
+		}
+		under := e.Type
+		if under == "" {
+			under = "int"
+		}
+		h.Typedefs[e.Name] = &Typedef{Name: e.Name, Type: Type{Name: under}}
+		return nil
+	}
+
+	// Function pointer, e.g. “typedef void (*Name)(args)”.
+	if i := indexToken(toks, "("); i >= 0 && i+3 < len(toks) && toks[i+1] == "*" && toks[i+3] == ")" {
+		name := toks[i+2]
+		if !isIdent(name) {
+			return fmt.Errorf("invalid function pointer typedef name %q", name)
+		}
+		h.Typedefs[name] = &Typedef{Name: name, Func: true}
+		return nil
+	}
+
+	var isStruct bool
+	if i := indexToken(toks, "{"); i >= 0 {
+		// Struct or union definition, e.g. “typedef struct {...} Name”.
+		end := skipBraces(toks, i)
+		if end+2 != len(toks) || !isIdent(toks[end+1]) {
+			return fmt.Errorf("unsupported typedef %q", joinTokens(toks))
+		}
+		name := toks[end+1]
+		h.Typedefs[name] = &Typedef{Name: name, Type: Type{Name: name}, Struct: true}
+		return nil
+	}
+	for _, tok := range toks {
+		switch tok {
+		case "struct", "union":
+			isStruct = true
+		case "(", "[", "^":
+			return fmt.Errorf("unsupported typedef %q", joinTokens(toks))
+		}
+	}
+	t, name, err := parseDecl(toks, true)
+	if err != nil {
+		return err
+	}
+	if name == "" {
+		return fmt.Errorf("missing typedef name in %q", joinTokens(toks))
+	}
+	h.Typedefs[name] = &Typedef{Name: name, Type: t, Struct: isStruct}
+	return nil
+}
+
+// parseEnum parses enum declaration. It returns nil if toks is not an enum.
+// For typedef declarations, the enum name is set to the typedef name.
+func (h *Header) parseEnum(toks []string) (*Enum, error) {
+	e := &Enum{}
+	var body []string
+	switch toks[0] {
+	case "CF_ENUM", "CF_OPTIONS", "CF_CLOSED_ENUM":
+		// CF_ENUM(Type, Name) { ... } or CF_ENUM(Type) { ... }
+		e.Options = toks[0] == "CF_OPTIONS"
+		end := skipGroup(toks, 1)
+		args := toks[2 : end-1]
+		if i := indexToken(args, ","); i >= 0 {
+			e.Name = strings.Join(args[i+1:], "")
+			args = args[:i]
+		}
+		e.Type = strings.Join(args, " ")
+		body = toks[end:]
+	case "enum":
+		// enum [Tag] [: Type] { ... } [Name]
+		i := indexToken(toks, "{")
+		if i < 0 {
+			// Not a definition, e.g. “typedef enum Tag Name”.
+			return nil, nil
+		}
+		head := toks[1:i]
+		if j := indexToken(head, ":"); j >= 0 {
+			e.Type = strings.Join(head[j+1:], " ")
+		}
+		body = toks[i:]
+	default:
+		return nil, nil
+	}
+	if len(body) == 0 || body[0] != "{" {
+		return nil, fmt.Errorf("enum without body")
+	}
+	end := skipBraces(body, 0)
+	if end >= len(body) {
+		return nil, fmt.Errorf("unterminated enum body")
+	}
+	switch rest := body[end+1:]; len(rest) {
+	case 0:
+	case 1:
+		e.Name = rest[0]
+	default:
+		return nil, fmt.Errorf("unsupported enum declarator %q", joinTokens(rest))
+	}
+
+	elems := body[1:end]
+	var next int64
+	for len(elems) > 0 {
+		i := indexToken(elems, ",")
+		if i < 0 {
+			i = len(elems)
+		}
+		elem := elems[:i]
+		if i < len(elems) {
+			i++
+		}
+		elems = elems[i:]
+		if len(elem) == 0 {
+			continue
+		}
+		name := elem[0]
+		if !isIdent(name) {
+			return nil, fmt.Errorf("invalid enumerator name %q", name)
+		}
+		v := next
+		if len(elem) > 1 {
+			if elem[1] != "=" {
+				return nil, fmt.Errorf("unexpected %q after enumerator %s", elem[1], name)
+			}
+			var err error
+			if v, err = evalExpr(elem[2:], h.constValue, false); err != nil {
+				return nil, fmt.Errorf("enumerator %s: %w", name, err)
+			}
+		}
+		h.consts[name] = v
+		e.Constants = append(e.Constants, EnumConstant{Name: name, Value: v})
+		next = v + 1
+	}
+	h.Enums = append(h.Enums, e)
+	return e, nil
+}
@@ -0,0 +1,205 @@
+package zgen
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func parseTestHeaders(t *testing.T) *Header {
+	t.Helper()
+	h := NewHeader()
+	for _, name := range []string{
+		"System/Library/Frameworks/CoreFoundation.framework/Headers/CFBase.h",
+		"System/Library/Frameworks/CoreFoundation.framework/Headers/CFNumber.h",
+		"System/Library/Frameworks/CoreServices.framework/Frameworks/FSEvents.framework/Headers/FSEvents.h",
+	} {
+		src, err := os.ReadFile(filepath.Join("testdata", "headers", "sdk", filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := h.Parse(name, src); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return h
+}
+
+func TestHeaderTypedefs(t *testing.T) {
+	h := parseTestHeaders(t)
+	testCases := []Typedef{
+		{Name: "CFIndex", Type: Type{Name: "signed long"}},
+		{Name: "CFTypeID", Type: Type{Name: "unsigned long"}},
+		{Name: "CFTypeRef", Type: Type{Name: "void", Const: true, Pointers: 1}},
+		{Name: "CFStringRef", Type: Type{Name: "__CFString", Const: true, Pointers: 1}, Struct: true},
+		{Name: "FSEventStreamRef", Type: Type{Name: "__FSEventStream", Pointers: 1}, Struct: true},
+		{Name: "CFRange", Type: Type{Name: "CFRange"}, Struct: true},
+		{Name: "CFNumberType", Type: Type{Name: "CFIndex"}},
+		{Name: "FSEventStreamReleaseCallback", Func: true},
+	}
+	for _, want := range testCases {
+		got, ok := h.Typedefs[want.Name]
+		if !ok {
+			t.Errorf("missing typedef %s", want.Name)
+			continue
+		}
+		if !reflect.DeepEqual(*got, want) {
+			t.Errorf("typedef %s = %+v, want %+v", want.Name, *got, want)
+		}
+	}
+}
+
+func TestHeaderEnums(t *testing.T) {
+	h := parseTestHeaders(t)
+	enums := make(map[string]*Enum)
+	for _, e := range h.Enums {
+		if e.Name != "" {
+			enums[e.Name] = e
+		}
+	}
+	e := enums["CFComparisonResult"]
+	if e == nil {
+		t.Fatal("missing CFComparisonResult enum")
+	}
+	want := &Enum{
+		Name: "CFComparisonResult",
+		Type: "CFIndex",
+		Constants: []EnumConstant{
+			{Name: "kCFCompareLessThan", Value: -1},
+			{Name: "kCFCompareEqualTo", Value: 0},
+			{Name: "kCFCompareGreaterThan", Value: 1},
+		},
+	}
+	if !reflect.DeepEqual(e, want) {
+		t.Errorf("CFComparisonResult = %+v, want %+v", e, want)
+	}
+	if n := len(enums["CFNumberType"].Constants); n != 17 {
+		t.Errorf("CFNumberType has %d constants, want 17", n)
+	}
+
+	for name, want := range map[string]int64{
+		"kCFNotFound":                             -1,
+		"kCFNumberCGFloatType":                    16,
+		"kFSEventStreamCreateFlagFileEvents":      0x10,
+		"kFSEventStreamCreateFlagUseExtendedData": 0x40,
+		"kFSEventStreamEventIdSinceNow":           -1,
+	} {
+		if got, ok := h.consts[name]; !ok || got != want {
+			t.Errorf("%s = %d (found %v), want %d", name, got, ok, want)
+		}
+	}
+}
+
+func TestHeaderProtos(t *testing.T) {
+	h := parseTestHeaders(t)
+	for name, want := range map[string]string{
+		"CFGetTypeID":                  "CFTypeID CFGetTypeID(CFTypeRef cf)",
+		"CFNumberGetTypeID":            "CFTypeID CFNumberGetTypeID(void)",
+		"CFNumberCreate":               "CFNumberRef CFNumberCreate(CFAllocatorRef allocator, CFNumberType theType, const void *valuePtr)",
+		"FSEventsGetCurrentEventId":    "FSEventStreamEventId FSEventsGetCurrentEventId(void)",
+		"FSEventStreamCopyDescription": "CFStringRef FSEventStreamCopyDescription(ConstFSEventStreamRef streamRef)",
+	} {
+		p, ok := h.Protos[name]
+		if !ok {
+			t.Errorf("missing prototype for %s", name)
+			continue
+		}
+		if got := p.String(); got != want {
+			t.Errorf("prototype for %s = %q, want %q", name, got, want)
+		}
+	}
+	if p := h.Protos["CFCopyDescription"]; p == nil || !p.Retained {
+		t.Error("CFCopyDescription must be annotated with CF_RETURNS_RETAINED")
+	}
+	if p := h.Protos["FSEventsGetCurrentEventId"]; p == nil || p.Availability != "__OSX_AVAILABLE_STARTING(__MAC_10_5, __IPHONE_6_0)" {
+		t.Errorf("unexpected availability for FSEventsGetCurrentEventId: %+v", p)
+	}
+	for _, name := range []string{
+		"CFRangeMake", // inline function
+		"FSEventsPurgeEventsForDeviceUpToEventId", // variadic function
+	} {
+		if _, ok := h.Protos[name]; ok {
+			t.Errorf("unexpected prototype for %s", name)
+		}
+	}
+	if _, ok := h.Globals["kCFAllocatorDefault"]; !ok {
+		t.Error("missing kCFAllocatorDefault global")
+	}
+}
+
+func TestHeaderPreprocessor(t *testing.T) {
+	const src = `
+#define A 2
+#define B (A << 1)
+#if B == 4 && !defined(C) && !__has_feature(objc_arc)
+int ok1(void);
+#elif 1
+int bad1(void);
+#else
+int bad2(void);
+#endif
+#ifdef C
+int bad3(void);
+#elif defined A
+int ok2(void);
+#endif
+#if 0
+#if 1
+int bad4(void);
+#else
+int bad5(void);
+#endif
+#endif
+enum { kX = B + 1, kY };
+`
+	h := NewHeader()
+	if err := h.Parse("test.h", []byte(src)); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for name := range h.Protos {
+		names = append(names, name)
+	}
+	if len(names) != 2 || h.Protos["ok1"] == nil || h.Protos["ok2"] == nil {
+		t.Errorf("unexpected prototypes %v", names)
+	}
+	if h.consts["kX"] != 5 || h.consts["kY"] != 6 {
+		t.Errorf("unexpected enum values %v", h.consts)
+	}
+
+	for _, src := range []string{
+		"#if 1\n",
+		"#endif\n",
+		"#else\n",
+	} {
+		if err := NewHeader().Parse("test.h", []byte(src)); err == nil {
+			t.Errorf("expected an error for %q", src)
+		}
+	}
+}
+
+func TestIntroducedMacOS(t *testing.T) {
+	for s, want := range map[string][]int{
+		"API_AVAILABLE(macos(10.15), ios(13.0))":              {10, 15},
+		"API_AVAILABLE(ios(13.0), macosx(11.0))":              {11, 0},
+		"__OSX_AVAILABLE_STARTING(__MAC_10_5, __IPHONE_6_0)":  {10, 5},
+		"CF_AVAILABLE(10_7, 5_0)":                             {10, 7},
+		"CF_AVAILABLE_MAC(10_6)":                              {10, 6},
+		"AVAILABLE_MAC_OS_X_VERSION_10_4_AND_LATER":           {10, 4},
+		"__OSX_AVAILABLE_STARTING(__MAC_10_10_3, __IPHONE_8)": {10, 10, 3},
+		"API_AVAILABLE(ios(13.0))":                            nil,
+		"__OSX_AVAILABLE_STARTING(__MAC_NA, __IPHONE_6_0)":    nil,
+		"": nil,
+	} {
+		got, ok := introducedMacOS(s)
+		if ok != (want != nil) || !reflect.DeepEqual(got, want) {
+			t.Errorf("introducedMacOS(%q) = %v, %v, want %v", s, got, ok, want)
+		}
+	}
+	if compareVersions([]int{10, 13}, []int{10, 13, 0}) != 0 ||
+		compareVersions([]int{10, 9}, []int{10, 13}) >= 0 ||
+		compareVersions([]int{11}, []int{10, 15, 7}) <= 0 {
+		t.Error("unexpected version order")
+	}
+}
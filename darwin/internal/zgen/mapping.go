@@ -0,0 +1,97 @@
+package zgen
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+)
+
+// Mapping is a key with a list of elements in the mapping file.
+type Mapping struct {
+	From string
+	To   []string
+
+	// Doc is the doc comment for the key.
+	Doc string
+	// ToDocs are doc comments for the elements.
+	ToDocs []string
+}
+
+// ParseMappings parses the mapping file contents.
+//
+// The file consists of keys, i.e. lines that end with a colon, followed by
+// elements, i.e. lines that start with a dash. Everything after a hash sign is
+// a comment.
+//
+// Lines that start with a double slash are doc comments for the following key
+// or element. Unlike hash comments, doc comments are preserved verbatim, and
+// a blank line discards the pending doc comment.
+func ParseMappings(b []byte) ([]Mapping, error) {
+	var mappings []Mapping
+	var current *Mapping
+	var doc []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if s := strings.TrimSpace(line); strings.HasPrefix(s, "//") {
+			doc = append(doc, s)
+			continue
+		}
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			doc = nil
+			continue
+		}
+		switch {
+		case strings.HasSuffix(line, ":"):
+			line = strings.TrimSuffix(line, ":")
+			line = strings.TrimSpace(line)
+			mappings = append(mappings, Mapping{
+				From: line,
+				Doc:  strings.Join(doc, "\n"),
+			})
+			current = &mappings[len(mappings)-1]
+			doc = nil
+			continue
+		case strings.HasPrefix(line, "-"):
+			line = strings.TrimPrefix(line, "-")
+			line = strings.TrimSpace(line)
+			if current == nil {
+				return nil, fmt.Errorf("mapping element %q before key", line)
+			}
+			current.To = append(current.To, line)
+			current.ToDocs = append(current.ToDocs, strings.Join(doc, "\n"))
+			doc = nil
+			continue
+		}
+		return nil, fmt.Errorf("unrecognized line %q", line)
+	}
+	return mappings, scanner.Err()
+}
+
+// loadMappings loads mappings from the file with the given name. It returns
+// nil mappings and no error if the file does not exist.
+func loadMappings(fsys fs.FS, fileName string) ([]Mapping, error) {
+	b, err := fs.ReadFile(fsys, fileName)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	xs, err := ParseMappings(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fileName, err)
+	}
+	if xs == nil {
+		return []Mapping{}, nil
+	}
+	return xs, nil
+}
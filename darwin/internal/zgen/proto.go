@@ -0,0 +1,267 @@
+package zgen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Proto is a C function prototype.
+type Proto struct {
+	Name   string
+	Result Type
+	Params []Param
+
+	// Retained is true if the function is annotated with
+	// CF_RETURNS_RETAINED, i.e. the caller owns the returned reference.
+	Retained bool
+	// Availability is the availability annotation from the header, e.g.
+	// “API_AVAILABLE(macos(10.5))”.
+	Availability string
+}
+
+// Param is a C function parameter.
+type Param struct {
+	Name string // may be empty
+	Type Type
+}
+
+// Type is a C type in a function prototype.
+type Type struct {
+	// Name is the base type name, e.g. “CFIndex” or “unsigned long”.
+	Name string
+	// Const is true if the base type is const-qualified.
+	Const bool
+	// Pointers is the number of pointer indirections.
+	Pointers int
+	// Nullable is true if the type has _Nullable qualifier.
+	Nullable bool
+}
+
+// IsVoid returns true for void type.
+func (t Type) IsVoid() bool {
+	return t.Name == "void" && t.Pointers == 0
+}
+
+// String returns the C representation of the type.
+func (t Type) String() string {
+	var b strings.Builder
+	if t.Const {
+		b.WriteString("const ")
+	}
+	b.WriteString(t.Name)
+	if t.Pointers > 0 {
+		b.WriteByte(' ')
+		b.WriteString(strings.Repeat("*", t.Pointers))
+	}
+	if t.Nullable {
+		if t.Pointers == 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString("_Nullable")
+	}
+	return b.String()
+}
+
+// String returns the C representation of the prototype.
+func (p *Proto) String() string {
+	var b strings.Builder
+	writeDecl(&b, p.Result, p.Name)
+	b.WriteByte('(')
+	if len(p.Params) == 0 {
+		b.WriteString("void")
+	}
+	for i, param := range p.Params {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		writeDecl(&b, param.Type, param.Name)
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+func writeDecl(b *strings.Builder, t Type, name string) {
+	s := t.String()
+	b.WriteString(s)
+	if name == "" {
+		return
+	}
+	if !strings.HasSuffix(s, "*") {
+		b.WriteByte(' ')
+	}
+	b.WriteString(name)
+}
+
+// IsProto returns true if the mapping element looks like a function prototype
+// rather than a plain symbol name.
+func IsProto(s string) bool {
+	return strings.ContainsRune(s, '(')
+}
+
+// qualifiers are type qualifiers and annotations that do not affect the ABI.
+var qualifiers = map[string]bool{
+	"const":             true,
+	"volatile":          true,
+	"restrict":          true,
+	"__restrict":        true,
+	"_Nonnull":          true,
+	"_Nullable":         true,
+	"_Null_unspecified": true,
+	"__nonnull":         true,
+	"__nullable":        true,
+	"struct":            true,
+	"enum":              true,
+}
+
+// builtinTypeWords are C keywords that may form a multi-word type name.
+var builtinTypeWords = map[string]bool{
+	"void":     true,
+	"_Bool":    true,
+	"char":     true,
+	"short":    true,
+	"int":      true,
+	"long":     true,
+	"signed":   true,
+	"unsigned": true,
+	"float":    true,
+	"double":   true,
+}
+
+// ParseProto parses a C function prototype, e.g.
+//
+//	CFIndex CFDataGetLength(CFDataRef theData)
+func ParseProto(s string) (*Proto, error) {
+	toks, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+	lparen := indexToken(toks, "(")
+	if lparen < 1 || toks[len(toks)-1] != ")" {
+		return nil, fmt.Errorf("invalid prototype %q", s)
+	}
+	name := toks[lparen-1]
+	if !isIdent(name) {
+		return nil, fmt.Errorf("invalid function name in prototype %q", s)
+	}
+	result, _, err := parseDecl(toks[:lparen-1], false)
+	if err != nil {
+		return nil, fmt.Errorf("result type in prototype %q: %w", s, err)
+	}
+	p := &Proto{
+		Name:   name,
+		Result: result,
+	}
+	args := toks[lparen+1 : len(toks)-1]
+	if len(args) == 1 && args[0] == "void" || len(args) == 0 {
+		return p, nil
+	}
+	for len(args) > 0 {
+		i := indexToken(args, ",")
+		if i == -1 {
+			i = len(args)
+		}
+		t, name, err := parseDecl(args[:i], true)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d in prototype %q: %w", len(p.Params)+1, s, err)
+		}
+		if t.IsVoid() {
+			return nil, fmt.Errorf("parameter %d in prototype %q has void type", len(p.Params)+1, s)
+		}
+		p.Params = append(p.Params, Param{Name: name, Type: t})
+		if i == len(args) {
+			break
+		}
+		args = args[i+1:]
+		if len(args) == 0 {
+			return nil, fmt.Errorf("trailing comma in prototype %q", s)
+		}
+	}
+	return p, nil
+}
+
+// parseDecl parses a type with an optional declarator name.
+func parseDecl(toks []string, named bool) (t Type, name string, err error) {
+	var words []string
+	for _, tok := range toks {
+		switch {
+		case tok == "*":
+			t.Pointers++
+		case tok == "const" && t.Pointers == 0:
+			t.Const = true
+		case tok == "_Nullable" || tok == "__nullable":
+			t.Nullable = true
+		case qualifiers[tok]:
+		case isIdent(tok):
+			if t.Pointers > 0 {
+				if !named || name != "" {
+					return t, "", fmt.Errorf("unexpected %q", tok)
+				}
+				name = tok
+				continue
+			}
+			words = append(words, tok)
+		default:
+			return t, "", fmt.Errorf("unexpected %q", tok)
+		}
+	}
+	if len(words) == 0 {
+		return t, "", fmt.Errorf("missing type name")
+	}
+	// The last word is the declarator name unless it is a part of the
+	// type name, e.g. “unsigned long”.
+	if named && name == "" && len(words) > 1 {
+		last := words[len(words)-1]
+		if !builtinTypeWords[last] || !builtinTypeWords[words[len(words)-2]] {
+			name = last
+			words = words[:len(words)-1]
+		}
+	}
+	t.Name = strings.Join(words, " ")
+	return t, name, nil
+}
+
+func tokenize(s string) ([]string, error) {
+	var toks []string
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case strings.ContainsRune("*(),", c):
+			toks = append(toks, s[i:i+1])
+			i++
+		case c == '_' || unicode.IsLetter(c) || unicode.IsDigit(c):
+			j := i
+			for j < len(s) && (s[j] == '_' || unicode.IsLetter(rune(s[j])) || unicode.IsDigit(rune(s[j]))) {
+				j++
+			}
+			toks = append(toks, s[i:j])
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character %q in %q", c, s)
+		}
+	}
+	return toks, nil
+}
+
+func indexToken(toks []string, tok string) int {
+	for i, t := range toks {
+		if t == tok {
+			return i
+		}
+	}
+	return -1
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		if c != '_' && !unicode.IsLetter(c) && (i == 0 || !unicode.IsDigit(c)) {
+			return false
+		}
+	}
+	return true
+}
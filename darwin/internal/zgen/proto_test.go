@@ -0,0 +1,189 @@
+package zgen
+
+import (
+	"io/fs"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+// mapFS is a shorthand for in-memory file systems with text files.
+type mapFS map[string]string
+
+func (m mapFS) Open(name string) (fs.File, error) {
+	fsys := make(fstest.MapFS, len(m))
+	for k, v := range m {
+		fsys[k] = &fstest.MapFile{Data: []byte(v)}
+	}
+	return fsys.Open(name)
+}
+
+func TestParseProto(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want Proto
+	}{{
+		in: "CFIndex CFDataGetLength(CFDataRef theData)",
+		want: Proto{
+			Name:   "CFDataGetLength",
+			Result: Type{Name: "CFIndex"},
+			Params: []Param{{Name: "theData", Type: Type{Name: "CFDataRef"}}},
+		},
+	}, {
+		in: "void CFRunLoopRun(void)",
+		want: Proto{
+			Name:   "CFRunLoopRun",
+			Result: Type{Name: "void"},
+		},
+	}, {
+		in: "void *dlopen(const char *path, int mode)",
+		want: Proto{
+			Name:   "dlopen",
+			Result: Type{Name: "void", Pointers: 1},
+			Params: []Param{
+				{Name: "path", Type: Type{Name: "char", Const: true, Pointers: 1}},
+				{Name: "mode", Type: Type{Name: "int"}},
+			},
+		},
+	}, {
+		in: "unsigned long f(unsigned long, unsigned long long x)",
+		want: Proto{
+			Name:   "f",
+			Result: Type{Name: "unsigned long"},
+			Params: []Param{
+				{Type: Type{Name: "unsigned long"}},
+				{Name: "x", Type: Type{Name: "unsigned long long"}},
+			},
+		},
+	}, {
+		in: "CFArrayRef g(CFAllocatorRef _Nullable alloc, char **argv)",
+		want: Proto{
+			Name:   "g",
+			Result: Type{Name: "CFArrayRef"},
+			Params: []Param{
+				{Name: "alloc", Type: Type{Name: "CFAllocatorRef", Nullable: true}},
+				{Name: "argv", Type: Type{Name: "char", Pointers: 2}},
+			},
+		},
+	}}
+	for _, tc := range testCases {
+		p, err := ParseProto(tc.in)
+		if err != nil {
+			t.Errorf("ParseProto(%q): %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(*p, tc.want) {
+			t.Errorf("ParseProto(%q) = %+v, want %+v", tc.in, *p, tc.want)
+		}
+		// Formatting and parsing again must be idempotent.
+		q, err := ParseProto(p.String())
+		if err != nil {
+			t.Errorf("ParseProto(%q): %v", p.String(), err)
+			continue
+		}
+		if !reflect.DeepEqual(p, q) {
+			t.Errorf("ParseProto(%q) = %+v, want %+v", p.String(), *q, *p)
+		}
+	}
+}
+
+func TestParseProtoErrors(t *testing.T) {
+	for _, in := range []string{
+		"CFDataGetLength",
+		"CFIndex (CFDataRef theData)",
+		"CFIndex CFDataGetLength(CFDataRef theData",
+		"CFIndex CFDataGetLength(CFDataRef theData,)",
+		"CFIndex CFDataGetLength(void x)",
+		"CFIndex CFDataGetLength(CFDataRef theData[])",
+		"CFIndex CFDataGetLength(CFDataRef *a b)",
+	} {
+		if _, err := ParseProto(in); err == nil {
+			t.Errorf("ParseProto(%q): expected an error", in)
+		}
+	}
+}
+
+func TestGoFuncName(t *testing.T) {
+	for in, want := range map[string]string{
+		"CFDataGetLength":     "cfDataGetLength",
+		"FSEventStreamCreate": "fsEventStreamCreate",
+		"CFShow":              "cfShow",
+		"dlopen":              "dlopen",
+		"ABC":                 "abc",
+	} {
+		if got := GoFuncName(in); got != want {
+			t.Errorf("GoFuncName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCallSignature(t *testing.T) {
+	testCases := []struct {
+		proto string
+		out   string
+		args  []string
+	}{
+		{"void CFRelease(CFTypeRef cf)", "Void", []string{"Uintptr"}},
+		{"CFIndex CFDataGetLength(CFDataRef theData)", "OutInt", []string{"Uintptr"}},
+		{"Boolean CFRunLoopIsWaiting(CFRunLoopRef rl)", "OutBool", []string{"Uintptr"}},
+		{
+			"CFStringRef CFStringCreateWithBytes(CFAllocatorRef alloc, const UInt8 *bytes, CFIndex numBytes, CFStringEncoding encoding, Boolean isExternalRepresentation)",
+			"OutUintptr",
+			[]string{"Uintptr", "Bytes", "Int", "Uint32", "Bool"},
+		},
+		{"void *dlsym(void *handle, const char *symbol)", "OutUintptr", []string{"Uintptr", "UnsafePointer"}},
+		{
+			"Boolean CFArrayContainsValue(CFArrayRef theArray, CFRange range, const void *value)",
+			"OutBool",
+			[]string{"Uintptr", "Int", "Int", "Uintptr"},
+		},
+		{
+			"Boolean CFNumberGetValue(CFNumberRef number, CFNumberType theType, void *valuePtr)",
+			"OutBool",
+			[]string{"Uintptr", "Int", "UnsafePointer"},
+		},
+		{"CFAbsoluteTime CFDateGetAbsoluteTime(CFDateRef theDate)", "OutFloat64", []string{"Uintptr"}},
+	}
+	mappings, err := ParseMappings([]byte(`
+scalar:
+- Boolean bool
+- UInt8 uint8
+- CFIndex int
+- CFStringEncoding uint32
+- CFNumberType int
+- CFAbsoluteTime float64
+object:
+- CFTypeRef CFType
+- CFAllocatorRef CFAllocator
+- CFArrayRef CFArray
+- CFDataRef CFData
+- CFDateRef CFDate
+- CFNumberRef CFNumber
+- CFRunLoopRef CFRunLoop
+- CFStringRef CFString
+struct:
+- CFRange location int, length int
+memory:
+- CFNumberGetValue.valuePtr
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := ParseTypeMap(mappings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range testCases {
+		p, err := ParseProto(tc.proto)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, args, err := CallSignature(p, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != tc.out || !reflect.DeepEqual(args, tc.args) {
+			t.Errorf("CallSignature(%q) = %q, %q, want %q, %q", tc.proto, out, args, tc.out, tc.args)
+		}
+	}
+}
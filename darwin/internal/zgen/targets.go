@@ -0,0 +1,119 @@
+package zgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Target is an operating system and architecture pair that trampolines are
+// generated for.
+type Target struct {
+	GOOS   string
+	GOARCH string
+}
+
+// String returns the target in GOOS/GOARCH form.
+func (t Target) String() string {
+	return t.GOOS + "/" + t.GOARCH
+}
+
+// DefaultTargets is the list of targets used when Config.Targets is empty.
+var DefaultTargets = []Target{
+	{GOOS: "darwin", GOARCH: "amd64"},
+	{GOOS: "darwin", GOARCH: "arm64"},
+}
+
+// asmBranch is the unconditional branch mnemonic for supported architectures.
+var asmBranch = map[string]string{
+	"386":     "JMP",
+	"amd64":   "JMP",
+	"arm":     "B",
+	"arm64":   "B",
+	"riscv64": "JMP",
+}
+
+// ParseTargets parses a comma-separated list of GOOS/GOARCH pairs, e.g.
+// “darwin/amd64,linux/amd64”.
+func ParseTargets(s string) ([]Target, error) {
+	var targets []Target
+	seen := make(map[Target]bool)
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		i := strings.IndexByte(f, '/')
+		if i < 0 {
+			return nil, fmt.Errorf("invalid target %q: expected GOOS/GOARCH", f)
+		}
+		t := Target{GOOS: f[:i], GOARCH: f[i+1:]}
+		if err := t.validate(); err != nil {
+			return nil, err
+		}
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		targets = append(targets, t)
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no targets in %q", s)
+	}
+	return targets, nil
+}
+
+func (t Target) validate() error {
+	if !isIdent(t.GOOS) {
+		return fmt.Errorf("invalid target %q: bad GOOS", t)
+	}
+	if _, ok := asmBranch[t.GOARCH]; !ok {
+		return fmt.Errorf("invalid target %q: unsupported GOARCH", t)
+	}
+	return nil
+}
+
+// targetOSes returns the sorted list of unique operating systems in targets.
+func targetOSes(targets []Target) []string {
+	seen := make(map[string]bool)
+	var oses []string
+	for _, t := range targets {
+		if !seen[t.GOOS] {
+			seen[t.GOOS] = true
+			oses = append(oses, t.GOOS)
+		}
+	}
+	sort.Strings(oses)
+	return oses
+}
+
+// parseLibrary parses the library key of ztrampolines.txt and zglobals.txt
+// mapping files. The first field is the default library path that is usually
+// a framework path on darwin. It may be followed by per-OS overrides, e.g.
+//
+//	/usr/lib/libSystem.B.dylib linux=libc.so.6
+func parseLibrary(key string) (string, map[string]string, error) {
+	fields := strings.Fields(key)
+	if len(fields) == 0 {
+		return "", nil, fmt.Errorf("empty library name")
+	}
+	lib := fields[0]
+	if strings.IndexByte(lib, '=') >= 0 {
+		return "", nil, fmt.Errorf("missing default library in %q", key)
+	}
+	var overrides map[string]string
+	for _, f := range fields[1:] {
+		i := strings.IndexByte(f, '=')
+		if i <= 0 || i == len(f)-1 || !isIdent(f[:i]) {
+			return "", nil, fmt.Errorf("invalid library override %q: expected GOOS=library", f)
+		}
+		if overrides == nil {
+			overrides = make(map[string]string)
+		}
+		if _, ok := overrides[f[:i]]; ok {
+			return "", nil, fmt.Errorf("duplicate library override for %s", f[:i])
+		}
+		overrides[f[:i]] = f[i+1:]
+	}
+	return lib, overrides, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * Test fixture that mimics the structure of CoreFoundation headers. It is
+ * written by hand and contains only the declarations needed by zgen tests.
+ */
+
+#if !defined(__COREFOUNDATION_CFBASE__)
+#define __COREFOUNDATION_CFBASE__ 1
+
+#include <stdint.h>
+
+#if defined(__cplusplus)
+extern "C" {
+#endif
+
+#define CF_EXPORT extern
+#define CF_INLINE static __inline__
+#define CF_ENUM(_type, _name) enum _name : _type _name; enum _name : _type
+#define CF_OPTIONS(_type, _name) enum _name : _type _name; enum _name : _type
+#define CF_RETURNS_RETAINED __attribute__((cf_returns_retained))
+
+CF_EXTERN_C_BEGIN
+CF_ASSUME_NONNULL_BEGIN
+
+typedef unsigned char Boolean;
+typedef unsigned char UInt8;
+typedef unsigned int UInt32;
+
+#if __LLP64__
+typedef unsigned long long CFTypeID;
+typedef signed long long CFIndex;
+#else
+typedef unsigned long CFTypeID;
+typedef signed long CFIndex;
+#endif
+
+typedef const void * CFTypeRef;
+typedef const struct CF_BRIDGED_TYPE(NSString) __CFString * CFStringRef;
+typedef const struct CF_BRIDGED_TYPE(id) __CFAllocator * CFAllocatorRef;
+typedef double CFTimeInterval;
+
+typedef CF_ENUM(CFIndex, CFComparisonResult) {
+    kCFCompareLessThan = -1L,
+    kCFCompareEqualTo = 0,
+    kCFCompareGreaterThan = 1
+};
+
+enum {
+    kCFNotFound = -1
+};
+
+typedef struct {
+    CFIndex location;
+    CFIndex length;
+} CFRange;
+
+CF_INLINE CFRange CFRangeMake(CFIndex loc, CFIndex len) {
+    CFRange range;
+    range.location = loc;
+    range.length = len;
+    return range;
+}
+
+CF_EXPORT
+const CFAllocatorRef kCFAllocatorDefault;
+
+CF_EXPORT
+CFTypeID CFGetTypeID(CFTypeRef cf);
+
+CF_EXPORT
+CFTypeRef CFRetain(CFTypeRef cf);
+
+CF_EXPORT
+void CFRelease(CFTypeRef cf);
+
+CF_EXPORT
+CFStringRef CFCopyDescription(CFTypeRef cf) CF_RETURNS_RETAINED;
+
+CF_ASSUME_NONNULL_END
+CF_EXTERN_C_END
+
+#if defined(__cplusplus)
+}
+#endif
+
+#endif /* ! __COREFOUNDATION_CFBASE__ */
@@ -0,0 +1,54 @@
+/*
+ * Test fixture that mimics the structure of CoreFoundation headers.
+ */
+
+#if !defined(__COREFOUNDATION_CFNUMBER__)
+#define __COREFOUNDATION_CFNUMBER__ 1
+
+CF_IMPLICIT_BRIDGING_ENABLED
+CF_EXTERN_C_BEGIN
+
+typedef const struct CF_BRIDGED_TYPE(NSNumber) __CFNumber * CFNumberRef;
+
+typedef CF_ENUM(CFIndex, CFNumberType) {
+    /* Fixed-width types */
+    kCFNumberSInt8Type = 1,
+    kCFNumberSInt16Type = 2,
+    kCFNumberSInt32Type = 3,
+    kCFNumberSInt64Type = 4,
+    kCFNumberFloat32Type = 5,
+    kCFNumberFloat64Type = 6, /* 64-bit IEEE 754 */
+    /* Basic C types */
+    kCFNumberCharType = 7,
+    kCFNumberShortType = 8,
+    kCFNumberIntType = 9,
+    kCFNumberLongType = 10,
+    kCFNumberLongLongType = 11,
+    kCFNumberFloatType = 12,
+    kCFNumberDoubleType = 13,
+    /* Other */
+    kCFNumberCFIndexType = 14,
+    kCFNumberNSIntegerType API_AVAILABLE(macos(10.5), ios(2.0)) = 15,
+    kCFNumberCGFloatType API_AVAILABLE(macos(10.5), ios(2.0)) = 16,
+    kCFNumberMaxType = 16
+};
+
+CF_EXPORT
+CFTypeID CFNumberGetTypeID(void);
+
+CF_EXPORT
+CFNumberRef CFNumberCreate(CFAllocatorRef allocator, CFNumberType theType, const void *valuePtr);
+
+CF_EXPORT
+CFNumberType CFNumberGetType(CFNumberRef number);
+
+CF_EXPORT
+Boolean CFNumberGetValue(CFNumberRef number, CFNumberType theType, void *valuePtr);
+
+CF_EXPORT
+CFComparisonResult CFNumberCompare(CFNumberRef number, CFNumberRef otherNumber, void *context);
+
+CF_EXTERN_C_END
+CF_IMPLICIT_BRIDGING_DISABLED
+
+#endif /* ! __COREFOUNDATION_CFNUMBER__ */
@@ -0,0 +1,61 @@
+/*
+ * Test fixture that mimics the structure of FSEvents header.
+ */
+
+#ifndef __FSEVENTS__
+#define __FSEVENTS__
+
+#pragma once
+
+CF_ASSUME_NONNULL_BEGIN
+
+typedef UInt32 FSEventStreamCreateFlags;
+
+CF_ENUM(UInt32) {
+  kFSEventStreamCreateFlagNone  = 0x00000000,
+  kFSEventStreamCreateFlagUseCFTypes = 0x00000001,
+  kFSEventStreamCreateFlagNoDefer = 0x00000002,
+  kFSEventStreamCreateFlagWatchRoot = 0x00000004,
+  kFSEventStreamCreateFlagIgnoreSelf __OSX_AVAILABLE_STARTING(__MAC_10_6, __IPHONE_6_0) = 0x00000008,
+  kFSEventStreamCreateFlagFileEvents __OSX_AVAILABLE_STARTING(__MAC_10_7, __IPHONE_6_0) = 0x00000010,
+  kFSEventStreamCreateFlagMarkSelf = 1 << 5,
+  kFSEventStreamCreateFlagUseExtendedData = kFSEventStreamCreateFlagMarkSelf << 1
+};
+
+typedef UInt64 FSEventStreamEventId;
+
+enum {
+  kFSEventStreamEventIdSinceNow = 0xFFFFFFFFFFFFFFFFULL
+};
+
+typedef struct __FSEventStream* FSEventStreamRef;
+typedef const struct __FSEventStream* ConstFSEventStreamRef;
+
+typedef CALLBACK_API_C( void , FSEventStreamCallback )(
+  ConstFSEventStreamRef streamRef,
+  void * _Nullable clientCallBackInfo,
+  size_t numEvents,
+  void * eventPaths,
+  const FSEventStreamEventFlags * _Nonnull eventFlags,
+  const FSEventStreamEventId * _Nonnull eventIds);
+
+typedef void (*FSEventStreamReleaseCallback)(const void *info);
+
+extern FSEventStreamEventId
+FSEventsGetCurrentEventId(void) __OSX_AVAILABLE_STARTING(__MAC_10_5, __IPHONE_6_0);
+
+extern void
+FSEventStreamShow(ConstFSEventStreamRef streamRef) __OSX_AVAILABLE_STARTING(__MAC_10_5, __IPHONE_6_0);
+
+extern CF_RETURNS_RETAINED CFStringRef
+FSEventStreamCopyDescription(ConstFSEventStreamRef streamRef) __OSX_AVAILABLE_STARTING(__MAC_10_5, __IPHONE_6_0);
+
+extern Boolean
+FSEventStreamSetExclusionPaths(FSEventStreamRef streamRef, CFArrayRef pathsToExclude) __OSX_AVAILABLE_STARTING(__MAC_10_9, __IPHONE_7_0);
+
+extern int
+FSEventsPurgeEventsForDeviceUpToEventId(int dev, FSEventStreamEventId eventId, ...);
+
+CF_ASSUME_NONNULL_END
+
+#endif /* __FSEVENTS__ */
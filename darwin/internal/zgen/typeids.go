@@ -0,0 +1,103 @@
+package zgen
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// TypeID is an opaque type declared in ztypeids.txt.
+type TypeID struct {
+	// GoType is the name of the Go interface type for instances.
+	GoType string
+	// Func is the C function that returns the type identifier, e.g.
+	// CFStringGetTypeID.
+	Func string
+}
+
+// parseTypeIDs parses ztypeids.txt mappings. Each key is a Go type with a
+// single element that is the name of the C function returning CFTypeID.
+func parseTypeIDs(mappings []Mapping) ([]TypeID, error) {
+	xs := make([]TypeID, 0, len(mappings))
+	seen := make(map[string]bool)
+	for _, m := range mappings {
+		if !isIdent(m.From) || goKeywords[m.From] {
+			return nil, fmt.Errorf("invalid type name %q", m.From)
+		}
+		if seen[m.From] {
+			return nil, fmt.Errorf("duplicate type %s", m.From)
+		}
+		seen[m.From] = true
+		if len(m.To) != 1 {
+			return nil, fmt.Errorf("type %s: expected exactly one type ID function", m.From)
+		}
+		if !isIdent(m.To[0]) {
+			return nil, fmt.Errorf("type %s: invalid function name %q", m.From, m.To[0])
+		}
+		xs = append(xs, TypeID{GoType: m.From, Func: m.To[0]})
+	}
+	return xs, nil
+}
+
+// genGoTypeIDs generates cached type identifier accessors, checked downcasts
+// from Object and typeIDOf function for generic code. The generated code
+// assumes that the package declares TypeID, Object and GetTypeID, and
+// ztrampolines.txt declares the type ID functions.
+func genGoTypeIDs(c *Config, xs []TypeID) []byte {
+	g := bytes.NewBuffer(nil)
+	fmt.Fprintln(g, codegenHeader)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `//go:build darwin`)
+	fmt.Fprintln(g, `// +build darwin`)
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, `package %s`+"\n", c.Package)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `import (`)
+	fmt.Fprintln(g, "\t"+`"sync"`)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, "\t"+`"github.com/noncgo/x/darwin/internal/types"`)
+	fmt.Fprintln(g, `)`)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `var typeIDs struct {`)
+	for i, x := range xs {
+		if i > 0 {
+			fmt.Fprintln(g)
+		}
+		fmt.Fprintf(g, "\t"+`%s_id TypeID`+"\n", x.GoType)
+		fmt.Fprintf(g, "\t"+`%s_once sync.Once`+"\n", x.GoType)
+	}
+	fmt.Fprintln(g, `}`)
+	for _, x := range xs {
+		fmt.Fprintln(g)
+		fmt.Fprintf(g, `// %[1]sGetTypeID returns the type identifier for %[1]s objects.`+"\n", x.GoType)
+		fmt.Fprintf(g, `// The value is obtained using %s on the first call.`+"\n", x.Func)
+		fmt.Fprintf(g, `func %sGetTypeID() TypeID {`+"\n", x.GoType)
+		fmt.Fprintf(g, "\t"+`typeIDs.%s_once.Do(func() {`+"\n", x.GoType)
+		fmt.Fprintf(g, "\t\t"+`typeIDs.%s_id = TypeID(%s())`+"\n", x.GoType, GoFuncName(x.Func))
+		fmt.Fprintln(g, "\t"+`})`)
+		fmt.Fprintf(g, "\t"+`return typeIDs.%s_id`+"\n", x.GoType)
+		fmt.Fprintln(g, `}`)
+		fmt.Fprintln(g)
+		fmt.Fprintf(g, `// As%[1]s returns v as %[1]s if the type identifier of v is`+"\n", x.GoType)
+		fmt.Fprintf(g, `// %sGetTypeID. It returns false if v is nil or has a different type.`+"\n", x.GoType)
+		fmt.Fprintf(g, `func As%[1]s(v Object) (%[1]s, bool) {`+"\n", x.GoType)
+		fmt.Fprintf(g, "\t"+`if v == nil || v.Pointer() == 0 || GetTypeID(v) != %sGetTypeID() {`+"\n", x.GoType)
+		fmt.Fprintln(g, "\t\t"+`return nil, false`)
+		fmt.Fprintln(g, "\t"+`}`)
+		fmt.Fprintln(g, "\t"+`return types.Pointer(v.Pointer()), true`)
+		fmt.Fprintln(g, `}`)
+	}
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// typeIDOf returns the type identifier for the type of variable that p points`)
+	fmt.Fprintln(g, `// to, e.g. StringGetTypeID for *String. It returns false if the type has no`)
+	fmt.Fprintln(g, `// associated type identifier.`)
+	fmt.Fprintln(g, `func typeIDOf(p interface{}) (TypeID, bool) {`)
+	fmt.Fprintln(g, "\t"+`switch p.(type) {`)
+	for _, x := range xs {
+		fmt.Fprintf(g, "\t"+`case *%s:`+"\n", x.GoType)
+		fmt.Fprintf(g, "\t\t"+`return %sGetTypeID(), true`+"\n", x.GoType)
+	}
+	fmt.Fprintln(g, "\t"+`}`)
+	fmt.Fprintln(g, "\t"+`return 0, false`)
+	fmt.Fprintln(g, `}`)
+	return g.Bytes()
+}
@@ -0,0 +1,208 @@
+package zgen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// genGoWrappers generates Go wrappers for functions with prototypes.
+func genGoWrappers(c *Config, syms []librarySymbols, m *TypeMap) ([]byte, error) {
+	var protos []Symbol
+	var usesDyld bool
+	for _, m := range syms {
+		for _, s := range m.Symbols {
+			if s.Proto != nil {
+				protos = append(protos, s)
+				usesDyld = usesDyld || s.Weak
+			}
+		}
+	}
+	if len(protos) == 0 {
+		return nil, nil
+	}
+
+	body := bytes.NewBuffer(nil)
+	var usesTypes, usesUnsafe bool
+	for _, s := range protos {
+		fmt.Fprintln(body)
+		if err := genGoWrapper(body, s.Proto, s.Weak, m, &usesTypes, &usesUnsafe); err != nil {
+			return nil, fmt.Errorf("%s: %w", s.Name, err)
+		}
+	}
+
+	g := bytes.NewBuffer(nil)
+	fmt.Fprintln(g, codegenHeader)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `//go:build darwin`)
+	fmt.Fprintln(g, `// +build darwin`)
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, `package %s`+"\n", c.Package)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `import (`)
+	if usesUnsafe {
+		fmt.Fprintln(g, "\t"+`"unsafe"`)
+		fmt.Fprintln(g)
+	}
+	fmt.Fprintln(g, "\t"+`"github.com/noncgo/x/darwin/internal/cabi"`)
+	if usesDyld {
+		fmt.Fprintln(g, "\t"+`"github.com/noncgo/x/darwin/internal/dyld"`)
+	}
+	if usesTypes {
+		fmt.Fprintln(g, "\t"+`"github.com/noncgo/x/darwin/internal/types"`)
+	}
+	fmt.Fprintln(g, `)`)
+	g.Write(body.Bytes())
+	return g.Bytes(), nil
+}
+
+// Wrappers for weak-linked functions additionally return an error that is
+// dyld.ErrUnavailable if the function is not available at run time.
+func genGoWrapper(g *bytes.Buffer, p *Proto, weak bool, m *TypeMap, usesTypes, usesUnsafe *bool) error {
+	var params, args []string
+	var prologue []string
+	var intArgs int
+	for i, param := range p.Params {
+		name := goParamName(param, i)
+		t, err := resolveParam(p, i, m)
+		if err != nil {
+			return err
+		}
+		if t.Kind == kindStruct {
+			if intArgs+len(t.Fields) > maxIntArgs {
+				return fmt.Errorf("structure parameter %s does not fit in registers", name)
+			}
+			intArgs += len(t.Fields)
+			base := strings.TrimSuffix(name, "_")
+			for _, f := range t.Fields {
+				field := base + strings.ToUpper(f.Name[:1]) + f.Name[1:]
+				params = append(params, field+" "+f.Type)
+				args = append(args, fmt.Sprintf("cabi.%s(%s)", cabiName(f.Type), field))
+			}
+			continue
+		}
+		if t.Scalar != "float32" && t.Scalar != "float64" {
+			intArgs++
+		}
+		params = append(params, name+" "+t.Name)
+		switch t.Kind {
+		case kindScalar:
+			args = append(args, fmt.Sprintf("cabi.%s(%s)", cabiName(t.Scalar), name))
+		case kindObject:
+			*usesTypes = true
+			if !t.Nullable {
+				args = append(args, fmt.Sprintf("cabi.Uintptr(%s.Pointer())", name))
+				break
+			}
+			addr := name + "Addr"
+			prologue = append(prologue,
+				fmt.Sprintf("var %s uintptr", addr),
+				fmt.Sprintf("if %s != nil {", name),
+				fmt.Sprintf("\t%s = %s.Pointer()", addr, name),
+				"}",
+			)
+			args = append(args, fmt.Sprintf("cabi.Uintptr(%s)", addr))
+		case kindAddr:
+			args = append(args, fmt.Sprintf("cabi.Uintptr(%s)", name))
+		case kindCString:
+			*usesUnsafe = true
+			args = append(args, fmt.Sprintf("cabi.UnsafePointer(unsafe.Pointer(%s))", name))
+		case kindBytes:
+			args = append(args, fmt.Sprintf("cabi.Bytes(%s)", name))
+		case kindPointer:
+			*usesUnsafe = true
+			args = append(args, fmt.Sprintf("cabi.UnsafePointer(%s)", name))
+		}
+	}
+	result, out, err := resultType(p.Result, m)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(g, "// %s calls %s C function.\n", GoFuncName(p.Name), p.Name)
+	fmt.Fprintf(g, "//\n")
+	fmt.Fprintf(g, "//\t%s\n", p)
+	if p.Availability != "" {
+		fmt.Fprintf(g, "//\n")
+		fmt.Fprintf(g, "// Availability: %s\n", p.Availability)
+	}
+	if p.Retained {
+		fmt.Fprintf(g, "//\n")
+		fmt.Fprintf(g, "// The caller owns the returned reference.\n")
+	}
+	if weak {
+		fmt.Fprintf(g, "//\n")
+		fmt.Fprintf(g, "// It returns dyld.ErrUnavailable if the function is not available.\n")
+	}
+	fmt.Fprintf(g, "func %s(%s)", GoFuncName(p.Name), strings.Join(params, ", "))
+	switch {
+	case weak && result != "":
+		fmt.Fprintf(g, " (%s, error)", result)
+	case weak:
+		fmt.Fprintf(g, " error")
+	case result != "":
+		fmt.Fprintf(g, " %s", result)
+	}
+	fmt.Fprintln(g, " {")
+	fn := fmt.Sprintf("extern_%s_trampolineABI0", p.Name)
+	if weak {
+		fn = "fn"
+		fmt.Fprintf(g, "\tfn := extern_%s_getAddr()\n", p.Name)
+		fmt.Fprintln(g, "\tif fn == 0 {")
+		if result != "" {
+			fmt.Fprintf(g, "\t\treturn %s, dyld.ErrUnavailable\n", zeroValue(result))
+		} else {
+			fmt.Fprintln(g, "\t\treturn dyld.ErrUnavailable")
+		}
+		fmt.Fprintln(g, "\t}")
+	}
+	for _, line := range prologue {
+		fmt.Fprintln(g, "\t"+line)
+	}
+	var outVar string
+	switch result {
+	case "":
+		out = "cabi.Void()"
+	case "types.Pointer":
+		*usesTypes = true
+		outVar = "uintptr"
+		out += "(&out)"
+	default:
+		outVar = result
+		out += "(&out)"
+	}
+	if outVar != "" {
+		fmt.Fprintf(g, "\tvar out %s\n", outVar)
+	}
+	fmt.Fprintln(g, "\tcabi.Call(")
+	fmt.Fprintf(g, "\t\t%s,\n", fn)
+	fmt.Fprintf(g, "\t\t%s,\n", out)
+	for _, arg := range args {
+		fmt.Fprintf(g, "\t\t%s,\n", arg)
+	}
+	fmt.Fprintln(g, "\t)")
+	var ret []string
+	switch result {
+	case "":
+	case "types.Pointer":
+		ret = append(ret, "types.Pointer(out)")
+	default:
+		ret = append(ret, "out")
+	}
+	if weak {
+		ret = append(ret, "nil")
+	}
+	if len(ret) > 0 {
+		fmt.Fprintf(g, "\treturn %s\n", strings.Join(ret, ", "))
+	}
+	fmt.Fprintln(g, "}")
+	return nil
+}
+
+// zeroValue returns the zero value literal for the Go result type.
+func zeroValue(result string) string {
+	if result == "bool" {
+		return "false"
+	}
+	return "0"
+}
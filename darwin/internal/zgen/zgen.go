@@ -0,0 +1,595 @@
+// Package zgen implements code generator for bindings to C libraries.
+//
+// The generator reads mapping files from the package directory and writes Go
+// and assembly source files next to them. The following mapping files are
+// supported.
+//
+// ztrampolines.txt maps libraries to functions that are called using cabi
+// package. Each element is either a symbol name or a C prototype, e.g.
+//
+//	/System/Library/Frameworks/CoreFoundation.framework/Versions/A/CoreFoundation:
+//	- CFIndex CFDataGetLength(CFDataRef theData)
+//	- weak Boolean CFFooBar(CFFooRef foo)
+//
+// The library may be followed by per-OS overrides, e.g. “linux=libc.so.6”,
+// for systems where the library has a different name or path.
+//
+// For each function, zgen emits a trampoline in ztrampolines_<goos>.go and
+// ztrampolines_<goos>_<goarch>.s files for every target in Config.Targets.
+// For functions with prototypes, it also emits a Go wrapper in zwrappers.go
+// file that converts arguments and result values. The wrapper name is derived
+// from the C function name, e.g. cfDataGetLength.
+//
+// Elements with weak prefix are weak-linked, i.e. the function is resolved
+// using dyld package on the first use instead of being imported at link time,
+// so that the binary still launches on systems where the function does not
+// exist. Such functions have extern_<name>_isAvailable predicate, and their
+// wrappers return dyld.ErrUnavailable error if the function is not available.
+// Note that weak-linked functions cannot be used in dyld package itself.
+//
+// zctypes.txt maps C types that are declared by libraries to Go types. Only C
+// scalar types, pointers and typedefs from zheaders.txt are known otherwise.
+// The keys are kinds of types, e.g.
+//
+//	scalar:
+//	- CFIndex int
+//	object:
+//	- CFStringRef CFString
+//	struct:
+//	- CFRange location int, length int
+//	func:
+//	- CFRunLoopTimerCallBack
+//	memory:
+//	- CFNumberGetValue.valuePtr
+//
+// Scalar types map to Go scalar types, object references map to interfaces in
+// internal/types package, structures with integer fields are passed by value
+// as one argument per field, function pointers map to uintptr, and memory
+// elements are void pointer parameters that map to unsafe.Pointer because they
+// point to a buffer provided by the caller.
+//
+// zheaders.txt maps directories relative to the SDK root to C header files,
+// e.g.
+//
+//	System/Library/Frameworks/CoreFoundation.framework/Headers:
+//	- CFBase.h
+//	- CFNumber.h
+//
+// Headers are parsed in order, and the declarations are used to resolve symbol
+// names in ztrampolines.txt to prototypes and to resolve typedefs in
+// prototypes. That is, a symbol name alone is enough to generate a wrapper for
+// a function declared in the headers. Such functions are weak-linked if the
+// availability annotation in the header says that they were introduced after
+// Config.MinMacOS, and wrappers of functions annotated with
+// CF_RETURNS_RETAINED document that the caller owns the result. Values in
+// zflags.txt may refer to enumerators declared in the headers.
+//
+// No package uses zheaders.txt yet, and it is only exercised by tests in
+// testdata, since generating from headers requires macOS SDK that is not
+// available on other systems where bindings are regenerated. Packages keep
+// complete prototypes and values in their mapping files until then.
+//
+// zglobals.txt maps libraries to global variables that are resolved at run
+// time using dyld package. Each element is the symbol kind, name and optional
+// accessor function name and result type, e.g.
+//
+//	/System/Library/Frameworks/CoreFoundation.framework/Versions/A/CoreFoundation:
+//	// AllocatorDefault returns an allocator that is synonym for NULL.
+//	- object kCFAllocatorDefault AllocatorDefault Allocator
+//	- data kCFTypeArrayCallBacks typeArrayCallbacks unsafe.Pointer
+//
+// The accessor for object symbol returns the reference stored in the global
+// variable, and the accessor for data symbol returns the address of the
+// symbol. Lookup failure is fatal unless the element has weak prefix, in which
+// case the address is zero, extern_<name>_isAvailable predicate reports false
+// and the accessor returns dyld.ErrUnavailable error.
+//
+// zflags.txt declares flags and enum types, e.g.
+//
+//	// CreateFlags is a set of flags for CreateStream.
+//	CreateFlags uint32 options CreateFlag:
+//	// CreateFlagNone is the default mode.
+//	- None = 0
+//	- UseCFTypes = 1 << 0
+//
+// For each type, zgen emits constants with doc comments, String method, Parse
+// function that accepts the string representation, e.g. “UseCFTypes|NoDefer”
+// for options, and MarshalText, UnmarshalText and Set methods, so that the
+// types can be used with encoding packages and as flag.Value. The generated
+// zflags.go file has no build constraints.
+//
+// ztypeids.txt maps Go types to C functions that return their type
+// identifiers, e.g.
+//
+//	String:
+//	- CFStringGetTypeID
+//
+// For each type, zgen emits StringGetTypeID function that caches the type
+// identifier, AsString function that converts Object to String if the object
+// has the matching type identifier, and typeIDOf function that maps pointers
+// to Go types to type identifiers for use in generic code.
+//
+// ztypes.txt declares the class hierarchy for internal/types package.
+package zgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const codegenHeader = `// Code generated by go run zgen.go. DO NOT EDIT.`
+
+// Config is the generator configuration.
+type Config struct {
+	// Package is the name of the package for generated files.
+	Package string
+	// SDK is the file system rooted at the SDK directory. It is required if
+	// the package has zheaders.txt mapping file.
+	SDK fs.FS
+	// Targets is the list of targets to generate trampolines for. If it is
+	// empty, DefaultTargets are used.
+	Targets []Target
+	// MinMacOS is the oldest macOS version that generated code supports,
+	// e.g. “10.13”. Functions declared in headers that were introduced in a
+	// later version are weak-linked. If it is empty, DefaultMinMacOS is
+	// used.
+	MinMacOS string
+}
+
+// Symbol is a symbol in a library.
+type Symbol struct {
+	Name  string
+	Proto *Proto // may be nil
+	// Weak is true if the symbol is resolved at run time and may be
+	// unavailable.
+	Weak bool
+}
+
+// weakPrefix is the prefix of mapping elements for weak-linked symbols.
+const weakPrefix = "weak "
+
+// cutWeak removes weak prefix from the mapping element.
+func cutWeak(s string) (string, bool) {
+	if !strings.HasPrefix(s, weakPrefix) {
+		return s, false
+	}
+	return strings.TrimSpace(s[len(weakPrefix):]), true
+}
+
+// librarySymbols is a list of symbols in a library.
+type librarySymbols struct {
+	Library string
+	// Overrides maps GOOS to the library name on that system.
+	Overrides map[string]string
+	Symbols   []Symbol
+}
+
+// libraryFor returns the library name for the given operating system.
+func (m *librarySymbols) libraryFor(goos string) string {
+	if lib, ok := m.Overrides[goos]; ok {
+		return lib
+	}
+	return m.Library
+}
+
+// Generate reads mapping files from the given directory and writes generated
+// files to the same directory. Previously generated files that are no longer
+// produced, e.g. trampolines for a removed target, are deleted.
+func Generate(dir string, c *Config) error {
+	files, err := Files(os.DirFS(dir), c)
+	if err != nil {
+		return err
+	}
+	if err := removeStale(dir, files); err != nil {
+		return err
+	}
+	for _, name := range sortedKeys(files) {
+		if err := os.WriteFile(filepath.Join(dir, name), files[name], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeStale removes generated files in dir that are not in files.
+func removeStale(dir string, files map[string][]byte) error {
+	for _, pattern := range []string{"z*.go", "z*.s"} {
+		names, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return err
+		}
+		for _, path := range names {
+			if _, ok := files[filepath.Base(path)]; ok {
+				continue
+			}
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if !bytes.HasPrefix(b, []byte(codegenHeader+"\n")) {
+				continue
+			}
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Files returns generated file contents for mapping files in fsys.
+func Files(fsys fs.FS, c *Config) (map[string][]byte, error) {
+	if c.Package == "" {
+		return nil, fmt.Errorf("package name must not be empty")
+	}
+	targets := c.Targets
+	if len(targets) == 0 {
+		targets = DefaultTargets
+	}
+	for _, t := range targets {
+		if err := t.validate(); err != nil {
+			return nil, err
+		}
+	}
+	minMacOS := c.MinMacOS
+	if minMacOS == "" {
+		minMacOS = DefaultMinMacOS
+	}
+	minVersion, err := parseVersion(minMacOS)
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+
+	h, err := loadHeaders(fsys, c.SDK)
+	if err != nil {
+		return nil, err
+	}
+	ctypes, err := loadTypeMap(fsys, h)
+	if err != nil {
+		return nil, err
+	}
+
+	trampolines, err := loadMappings(fsys, "ztrampolines.txt")
+	if err != nil {
+		return nil, err
+	}
+	if trampolines != nil {
+		syms, err := parseSymbols(trampolines, h, minVersion)
+		if err != nil {
+			return nil, fmt.Errorf("ztrampolines.txt: %w", err)
+		}
+		for _, goos := range targetOSes(targets) {
+			b, err := genGoTrampolines(c, goos, syms)
+			if err != nil {
+				return nil, fmt.Errorf("ztrampolines.txt: %w", err)
+			}
+			files["ztrampolines_"+goos+".go"] = b
+		}
+		for _, t := range targets {
+			name := "ztrampolines_" + t.GOOS + "_" + t.GOARCH + ".s"
+			files[name] = genAsmTrampolines(t, syms)
+		}
+		b, err := genGoWrappers(c, syms, ctypes)
+		if err != nil {
+			return nil, fmt.Errorf("ztrampolines.txt: %w", err)
+		}
+		if b != nil {
+			files["zwrappers.go"] = b
+		}
+	}
+
+	globals, err := loadMappings(fsys, "zglobals.txt")
+	if err != nil {
+		return nil, err
+	}
+	if globals != nil {
+		b, err := genGoGlobals(c, globals)
+		if err != nil {
+			return nil, fmt.Errorf("zglobals.txt: %w", err)
+		}
+		files["zglobals.go"] = b
+	}
+
+	flags, err := loadMappings(fsys, "zflags.txt")
+	if err != nil {
+		return nil, err
+	}
+	if flags != nil {
+		xs, err := parseFlags(flags, h)
+		if err != nil {
+			return nil, fmt.Errorf("zflags.txt: %w", err)
+		}
+		files["zflags.go"] = genGoFlags(c, xs)
+	}
+
+	typeIDs, err := loadMappings(fsys, "ztypeids.txt")
+	if err != nil {
+		return nil, err
+	}
+	if typeIDs != nil {
+		xs, err := parseTypeIDs(typeIDs)
+		if err != nil {
+			return nil, fmt.Errorf("ztypeids.txt: %w", err)
+		}
+		files["ztypeids.go"] = genGoTypeIDs(c, xs)
+	}
+
+	types, err := loadMappings(fsys, "ztypes.txt")
+	if err != nil {
+		return nil, err
+	}
+	if types != nil {
+		files["ztypes.go"] = genGoTypes(c, types)
+	}
+
+	for name, b := range files {
+		if !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		src, err := format.Source(b)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		files[name] = restoreBullets(src)
+	}
+	return files, nil
+}
+
+// restoreBullets rewrites list items in comments that format.Source
+// reformats since Go 1.19, e.g. “//   - item”, back to the bullet style of
+// the repository, i.e. “//  • item”, so that the output does not depend on
+// the Go version used to run the generator.
+func restoreBullets(src []byte) []byte {
+	lines := bytes.SplitAfter(src, []byte("\n"))
+	for i, line := range lines {
+		trimmed := bytes.TrimLeft(line, "\t")
+		if bytes.HasPrefix(trimmed, []byte("//   - ")) {
+			indent := line[:len(line)-len(trimmed)]
+			rest := trimmed[len("//   - "):]
+			lines[i] = append(append(append([]byte{}, indent...), "//  • "...), rest...)
+		}
+	}
+	return bytes.Join(lines, nil)
+}
+
+// parseSymbols parses symbols from the library mappings. If h is not nil,
+// symbol names are resolved to prototypes declared in headers, and functions
+// that were introduced after minMacOS are weak-linked.
+func parseSymbols(mappings []Mapping, h *Header, minMacOS []int) ([]librarySymbols, error) {
+	xs := make([]librarySymbols, 0, len(mappings))
+	for _, m := range mappings {
+		name, overrides, err := parseLibrary(m.From)
+		if err != nil {
+			return nil, err
+		}
+		lib := librarySymbols{Library: name, Overrides: overrides}
+		for _, s := range m.To {
+			s, weak := cutWeak(s)
+			if !IsProto(s) {
+				if !isIdent(s) {
+					return nil, fmt.Errorf("invalid symbol name %q", s)
+				}
+				var p *Proto
+				if h != nil {
+					p = h.Protos[s]
+				}
+				if p != nil && !weak {
+					v, ok := introducedMacOS(p.Availability)
+					weak = ok && compareVersions(v, minMacOS) > 0
+				}
+				lib.Symbols = append(lib.Symbols, Symbol{Name: s, Proto: p, Weak: weak})
+				continue
+			}
+			p, err := ParseProto(s)
+			if err != nil {
+				return nil, err
+			}
+			lib.Symbols = append(lib.Symbols, Symbol{Name: p.Name, Proto: p, Weak: weak})
+		}
+		xs = append(xs, lib)
+	}
+	return xs, nil
+}
+
+// genGoTrampolines generates trampoline declarations for the given operating
+// system. Weak-linked symbols are resolved using dyld and are therefore only
+// supported on darwin.
+func genGoTrampolines(c *Config, goos string, trampolines []librarySymbols) ([]byte, error) {
+	var weak []Symbol
+	var weakLibs []string
+	for _, m := range trampolines {
+		n := len(weak)
+		for _, s := range m.Symbols {
+			if s.Weak {
+				weak = append(weak, s)
+			}
+		}
+		if len(weak) > n {
+			weakLibs = append(weakLibs, m.libraryFor(goos))
+		}
+	}
+	if len(weak) > 0 && goos != "darwin" {
+		return nil, fmt.Errorf("weak symbol %s is not supported on %s", weak[0].Name, goos)
+	}
+
+	g := bytes.NewBuffer(nil)
+	fmt.Fprintln(g, codegenHeader)
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, `//go:build %s`+"\n", goos)
+	fmt.Fprintf(g, `// +build %s`+"\n", goos)
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, `package %s`+"\n", c.Package)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `import (`)
+	if len(weak) > 0 {
+		fmt.Fprintln(g, "\t"+`"sync"`)
+	}
+	fmt.Fprintln(g, "\t"+`"unsafe"`)
+	if len(weak) > 0 {
+		fmt.Fprintln(g)
+		fmt.Fprintln(g, "\t"+`"github.com/noncgo/x/darwin/internal/dyld"`)
+	}
+	fmt.Fprintln(g, `)`)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `const sizeofUintptr = unsafe.Sizeof(uintptr(0))`)
+	if goos != "darwin" {
+		// ELF targets need an explicit DT_NEEDED entry for each library.
+		fmt.Fprintln(g)
+		for _, m := range trampolines {
+			fmt.Fprintf(g, `//go:cgo_import_dynamic _ _ "%s"`+"\n", m.libraryFor(goos))
+		}
+	}
+	for _, m := range trampolines {
+		lib := m.libraryFor(goos)
+		for _, s := range m.Symbols {
+			if s.Weak {
+				continue
+			}
+			fn := s.Name
+			fmt.Fprintln(g)
+			if s.Proto != nil {
+				fmt.Fprintf(g, `// %s`+"\n", s.Proto)
+			}
+			fmt.Fprintf(g, `var extern_%s_trampolineABI0 uintptr`+"\n", fn)
+			fmt.Fprintln(g)
+			fmt.Fprintf(g, `//go:cgo_import_dynamic extern_%[1]s %[1]s "%[2]s"`+"\n", fn, lib)
+			fmt.Fprintf(g, `func extern_%s_trampoline()`+"\n", fn)
+		}
+	}
+	if len(weak) == 0 {
+		return g.Bytes(), nil
+	}
+
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// Weak-linked functions are resolved on the first use.`)
+	fmt.Fprintln(g)
+	for _, lib := range weakLibs {
+		fmt.Fprintf(g, `//go:cgo_import_dynamic _ _ "%s"`+"\n", lib)
+	}
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `var weak struct {`)
+	for i, s := range weak {
+		if i > 0 {
+			fmt.Fprintln(g)
+		}
+		fmt.Fprintf(g, "\t"+`%s_addr uintptr`+"\n", s.Name)
+		fmt.Fprintf(g, "\t"+`%s_once sync.Once`+"\n", s.Name)
+	}
+	fmt.Fprintln(g, `}`)
+	for _, s := range weak {
+		fmt.Fprintln(g)
+		fmt.Fprintf(g, `// extern_%s_getAddr returns the function address or zero if it is not available.`+"\n", s.Name)
+		if s.Proto != nil {
+			fmt.Fprintln(g, `//`)
+			fmt.Fprintf(g, "//\t%s\n", s.Proto)
+		}
+		genGoLookup(g, "weak", s.Name, true)
+		fmt.Fprintln(g)
+		fmt.Fprintf(g, `// extern_%[1]s_isAvailable returns true if %[1]s is available at run time.`+"\n", s.Name)
+		fmt.Fprintf(g, `func extern_%s_isAvailable() bool {`+"\n", s.Name)
+		fmt.Fprintf(g, "\t"+`return extern_%s_getAddr() != 0`+"\n", s.Name)
+		fmt.Fprintln(g, `}`)
+	}
+	return g.Bytes(), nil
+}
+
+// genGoLookup generates extern_<name>_getAddr function that resolves the
+// symbol using dyld package and caches the result in the given struct
+// variable. Lookup failure is fatal unless weak is true.
+func genGoLookup(g *bytes.Buffer, v, name string, weak bool) {
+	fmt.Fprintf(g, `func extern_%s_getAddr() uintptr {`+"\n", name)
+	fmt.Fprintf(g, "\t"+`%s.%s_once.Do(func() {`+"\n", v, name)
+	fmt.Fprintf(g, "\t\t"+`sym, err := dyld.Lookup("%s")`+"\n", name)
+	fmt.Fprintln(g, "\t\t"+`if err != nil {`)
+	if weak {
+		fmt.Fprintln(g, "\t\t\t"+`return`)
+	} else {
+		fmt.Fprintln(g, "\t\t\t"+`panic(err)`)
+	}
+	fmt.Fprintln(g, "\t\t"+`}`)
+	fmt.Fprintf(g, "\t\t"+`%s.%s_addr = sym.Addr`+"\n", v, name)
+	fmt.Fprintln(g, "\t"+`})`)
+	fmt.Fprintf(g, "\t"+`return %s.%s_addr`+"\n", v, name)
+	fmt.Fprintln(g, `}`)
+}
+
+// genAsmTrampolines generates assembly trampolines for the given target.
+func genAsmTrampolines(t Target, trampolines []librarySymbols) []byte {
+	branch := asmBranch[t.GOARCH]
+	g := bytes.NewBuffer(nil)
+	fmt.Fprintln(g, codegenHeader)
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, `//go:build %s && %s`+"\n", t.GOOS, t.GOARCH)
+	fmt.Fprintf(g, `// +build %s,%s`+"\n", t.GOOS, t.GOARCH)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `#include "go_asm.h"`)
+	fmt.Fprintln(g, `#include "textflag.h"`)
+	for _, m := range trampolines {
+		for _, s := range m.Symbols {
+			if s.Weak {
+				continue
+			}
+			fn := s.Name
+			fmt.Fprintln(g)
+			fmt.Fprintf(g, `GLOBL ·extern_%s_trampolineABI0(SB),NOPTR|RODATA,$const_sizeofUintptr`+"\n", fn)
+			fmt.Fprintf(g, `DATA ·extern_%[1]s_trampolineABI0(SB)/const_sizeofUintptr,$·extern_%[1]s_trampoline(SB)`+"\n", fn)
+			fmt.Fprintf(g, `TEXT ·extern_%s_trampoline(SB),NOSPLIT,$0-0`+"\n", fn)
+			fmt.Fprintf(g, "\t"+`%s extern_%s(SB)`+"\n", branch, fn)
+		}
+	}
+	return g.Bytes()
+}
+
+func genGoTypes(c *Config, types []Mapping) []byte {
+	g := bytes.NewBuffer(nil)
+	fmt.Fprintln(g, codegenHeader)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `//go:build darwin`)
+	fmt.Fprintln(g, `// +build darwin`)
+	fmt.Fprintln(g)
+	fmt.Fprintf(g, `package %s`+"\n", c.Package)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// Pointer is an opaque reference that satisfies all interfaces in this package.`)
+	fmt.Fprintln(g, `type Pointer uintptr`)
+	fmt.Fprintln(g)
+	fmt.Fprintln(g, `// Pointer returns the underlying opaque pointer value.`)
+	fmt.Fprintln(g, `func (p Pointer) Pointer() uintptr { return uintptr(p) }`)
+	for _, m := range types {
+		typ := m.From
+		fmt.Fprintln(g)
+		fmt.Fprintf(g, `// private%[1]s implements the %[1]s interface.`+"\n", typ)
+		fmt.Fprintf(g, `func (p Pointer) private%s() {}`+"\n", typ)
+	}
+	for _, m := range types {
+		typ := m.From
+		fmt.Fprintln(g)
+		fmt.Fprintf(g, `// %[1]s is an opaque reference to %[1]s type.`+"\n", typ)
+		fmt.Fprintf(g, `type %s interface {`+"\n", typ)
+		for _, impl := range m.To {
+			fmt.Fprintln(g, "\t"+impl)
+		}
+		if len(m.To) == 0 {
+			fmt.Fprintln(g, "\t"+`Pointer() uintptr`)
+		}
+		fmt.Fprintln(g)
+		fmt.Fprintf(g, "\t"+`private%s()`+"\n", typ)
+		fmt.Fprintln(g, `}`)
+	}
+	return g.Bytes()
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
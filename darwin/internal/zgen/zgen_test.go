@@ -0,0 +1,193 @@
+package zgen
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// testTargets overrides default targets for test cases in testdata.
+var testTargets = map[string][]Target{
+	"targets": {
+		{GOOS: "darwin", GOARCH: "amd64"},
+		{GOOS: "darwin", GOARCH: "arm64"},
+		{GOOS: "linux", GOARCH: "amd64"},
+	},
+}
+
+// testMinMacOS overrides the oldest supported macOS version for test cases in
+// testdata.
+var testMinMacOS = map[string]string{
+	"headers": "10.6",
+}
+
+func TestFiles(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		dir := dir
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			c := &Config{
+				Package:  "test",
+				Targets:  testTargets[filepath.Base(dir)],
+				MinMacOS: testMinMacOS[filepath.Base(dir)],
+			}
+			if sdk := filepath.Join(dir, "sdk"); isDir(sdk) {
+				c.SDK = os.DirFS(sdk)
+			}
+			files, err := Files(os.DirFS(dir), c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range sortedKeys(files) {
+				golden := filepath.Join(dir, name+".golden")
+				if *update {
+					if err := os.WriteFile(golden, files[name], 0o644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(files[name], want) {
+					t.Errorf("%s: generated output differs from %s\n%s", name, golden, files[name])
+				}
+			}
+		})
+	}
+}
+
+func TestFilesInvalidProto(t *testing.T) {
+	fsys := mapFS{
+		"ztrampolines.txt": "lib:\n- CFIndex CFDataGetLength(CFUnknownRef theData)\n",
+	}
+	if _, err := Files(fsys, &Config{Package: "test"}); err == nil {
+		t.Fatal("expected an error for unknown C type")
+	}
+}
+
+func TestFilesStructRegisters(t *testing.T) {
+	fsys := mapFS{
+		"zctypes.txt":      "struct:\n- CFRange location int, length int\n",
+		"ztrampolines.txt": "lib:\n- void f(long a, long b, long c, long d, long e, CFRange r)\n",
+	}
+	if _, err := Files(fsys, &Config{Package: "test"}); err == nil {
+		t.Fatal("expected an error for structure that does not fit in registers")
+	}
+}
+
+func TestFilesWithoutSDK(t *testing.T) {
+	fsys := mapFS{
+		"zheaders.txt":     "System/Library/Frameworks/CoreFoundation.framework/Headers:\n- CFBase.h\n",
+		"ztrampolines.txt": "lib:\n- CFRetain\n",
+	}
+	if _, err := Files(fsys, &Config{Package: "test"}); err == nil {
+		t.Fatal("expected an error for missing SDK")
+	}
+}
+
+func TestFilesWeakTarget(t *testing.T) {
+	fsys := mapFS{
+		"ztrampolines.txt": "lib linux=libc.so.6:\n- weak int f(void)\n",
+	}
+	c := &Config{Package: "test", Targets: []Target{{GOOS: "linux", GOARCH: "amd64"}}}
+	if _, err := Files(fsys, c); err == nil {
+		t.Fatal("expected an error for weak symbol on linux")
+	}
+}
+
+func TestParseTargets(t *testing.T) {
+	got, err := ParseTargets("darwin/amd64, linux/amd64,darwin/amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Target{{GOOS: "darwin", GOARCH: "amd64"}, {GOOS: "linux", GOARCH: "amd64"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseTargets() = %v, want %v", got, want)
+	}
+	for _, s := range []string{"", "darwin", "darwin/mips", "/amd64"} {
+		if _, err := ParseTargets(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestParseLibrary(t *testing.T) {
+	lib, overrides, err := parseLibrary("/usr/lib/libSystem.B.dylib linux=libc.so.6")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lib != "/usr/lib/libSystem.B.dylib" || !reflect.DeepEqual(overrides, map[string]string{"linux": "libc.so.6"}) {
+		t.Errorf("parseLibrary() = %q, %v", lib, overrides)
+	}
+	for _, s := range []string{"", "linux=libc.so.6", "lib linux=", "lib =x", "lib linux=a linux=b"} {
+		if _, _, err := parseLibrary(s); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func isDir(name string) bool {
+	fi, err := os.Stat(name)
+	return err == nil && fi.IsDir()
+}
+
+func TestFilesInvalidGlobal(t *testing.T) {
+	for _, s := range []string{
+		"kCFNull",
+		"const kCFNull",
+		"object kCFNull Null",
+		"object kCF-Null",
+		"object kCFNull type Null",
+	} {
+		fsys := mapFS{
+			"zglobals.txt": "lib:\n- " + s + "\n",
+		}
+		if _, err := Files(fsys, &Config{Package: "test"}); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestFilesInvalidTypeMap(t *testing.T) {
+	for _, s := range []string{
+		"type:\n- CFIndex int\n",
+		"scalar:\n- CFIndex\n",
+		"scalar:\n- CFIndex long\n",
+		"scalar:\n- CFIndex int\nobject:\n- CFIndex CFIndex\n",
+		"object:\n- CFStringRef\n",
+		"struct:\n- CFRange location int length int\n",
+		"struct:\n- CFPoint x float64, y float64\n",
+		"func:\n- CFRunLoopTimerCallBack uintptr\n",
+		"memory:\n- valuePtr\n",
+	} {
+		fsys := mapFS{"zctypes.txt": s}
+		if _, err := Files(fsys, &Config{Package: "test"}); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
+
+func TestFilesInvalidTypeID(t *testing.T) {
+	for _, s := range []string{
+		"String:\n",
+		"String:\n- CFStringGetTypeID\n- CFArrayGetTypeID\n",
+		"String:\n- CFStringGetTypeID\nString:\n- CFStringGetTypeID\n",
+		"type:\n- CFStringGetTypeID\n",
+		"String:\n- CFStringGetTypeID()\n",
+	} {
+		fsys := mapFS{"ztypeids.txt": s}
+		if _, err := Files(fsys, &Config{Package: "test"}); err == nil {
+			t.Errorf("expected an error for %q", s)
+		}
+	}
+}
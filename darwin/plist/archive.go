@@ -0,0 +1,395 @@
+package plist
+
+// This file implements encoding of keyed archives.
+//
+// References
+//  • https://developer.apple.com/documentation/foundation/nskeyedarchiver
+
+import (
+	"bytes"
+	"net/url"
+	"reflect"
+	"sort"
+	"time"
+)
+
+var (
+	urlType            = reflect.TypeOf((*url.URL)(nil))
+	uuidType           = reflect.TypeOf(UUID{})
+	archivedObjectType = reflect.TypeOf((*ArchivedObject)(nil))
+)
+
+// Archive returns the keyed archive of v with v as the root object in binary
+// property list format, i.e. the format of NSKeyedArchiver’s
+// archivedDataWithRootObject.
+//
+// Archive converts values as follows:
+//  • strings, byte slices, booleans, integers and floating point numbers to
+//    NSString, NSData and NSNumber objects stored directly in the archive;
+//  • time.Time to NSDate;
+//  • *url.URL to NSURL;
+//  • UUID to NSUUID;
+//  • Set to NSSet;
+//  • other slices and arrays to NSArray;
+//  • maps with string keys and structs to NSDictionary, with keys as
+//    described in Marshal;
+//  • *ArchivedObject to an instance of its class;
+//  • nil to NSNull in arrays, sets and dictionaries and to nil elsewhere.
+//
+// Fields of an ArchivedObject are stored as NSKeyedArchiver stores them:
+// booleans, numbers and byte slices directly in the instance, slices as
+// arrays of references, e.g. the “NS.objects” key of NSArray, and other
+// values as references to objects. Identical strings and ArchivedObject
+// pointers are stored once, so ArchivedObject values may form cycles. Other
+// pointers, maps and slices are also stored once, but may not form cycles.
+//
+// Archive returns UnsupportedTypeError and UnsupportedValueError as Marshal
+// does.
+func Archive(v interface{}) ([]byte, error) {
+	a := archiver{
+		e:         encoder{format: BinaryFormat, visiting: make(map[visitKey]bool)},
+		objects:   array{nullString},
+		strings:   make(map[string]UID),
+		classes:   make(map[string]UID),
+		instances: make(map[*ArchivedObject]UID),
+		shared:    make(map[visitKey]UID),
+	}
+	root, err := a.object(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	top := &dict{
+		keys: []string{"$version", "$archiver", "$top", "$objects"},
+		values: []value{
+			intOf(archiveVersion),
+			archiverName,
+			&dict{keys: []string{rootKey}, values: []value{root}},
+			a.objects,
+		},
+	}
+	var buf bytes.Buffer
+	writeBinary(&buf, top)
+	return buf.Bytes(), nil
+}
+
+// archiver converts Go values to objects of a keyed archive.
+type archiver struct {
+	// e converts values that are stored directly in the archive and
+	// detects cycles.
+	e       encoder
+	objects array
+	// strings, classes, instances and shared are the UIDs of strings,
+	// class descriptions, ArchivedObject values and other pointers, maps
+	// and slices that are already archived.
+	strings   map[string]UID
+	classes   map[string]UID
+	instances map[*ArchivedObject]UID
+	shared    map[visitKey]UID
+}
+
+// add appends the object and returns its UID.
+func (a *archiver) add(v value) UID {
+	a.objects = append(a.objects, v)
+	return UID(len(a.objects) - 1)
+}
+
+// object archives v and returns its UID. Nil values are the “$null” object.
+// Like NSKeyedArchiver, which archives each object once, non-nil pointers,
+// maps and slices that are already archived refer to the existing object.
+func (a *archiver) object(v reflect.Value) (UID, error) {
+	if !v.IsValid() {
+		return 0, nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			break
+		}
+		n := 0
+		if v.Kind() == reflect.Slice {
+			n = v.Len()
+		}
+		k := visitKey{v.Pointer(), v.Type(), n}
+		if uid, ok := a.shared[k]; ok {
+			return uid, nil
+		}
+		uid, err := a.archive(v)
+		if err != nil {
+			return 0, err
+		}
+		a.shared[k] = uid
+		return uid, nil
+	}
+	return a.archive(v)
+}
+
+// archive archives v and returns its UID.
+func (a *archiver) archive(v reflect.Value) (UID, error) {
+	switch v.Type() {
+	case timeType:
+		t := v.Interface().(time.Time)
+		return a.instance(a.add(nil), []string{"NSDate", "NSObject"}, []string{"NS.time"}, []value{real{v: absoluteTimeOf(t)}}), nil
+	case urlType:
+		if v.IsNil() {
+			return 0, nil
+		}
+		uid := a.add(nil)
+		rel, err := a.object(reflect.ValueOf(v.Interface().(*url.URL).String()))
+		if err != nil {
+			return 0, err
+		}
+		return a.instance(uid, []string{"NSURL", "NSObject"}, []string{"NS.base", "NS.relative"}, []value{UID(0), rel}), nil
+	case uuidType:
+		u := v.Interface().(UUID)
+		return a.instance(a.add(nil), []string{"NSUUID", "NSObject"}, []string{"NS.uuidbytes"}, []value{u[:]}), nil
+	case archivedObjectType:
+		if v.IsNil() {
+			return 0, nil
+		}
+		return a.archivedObject(v)
+	case bigIntType:
+		if v.IsNil() {
+			return 0, nil
+		}
+		return a.value(v)
+	case uidType:
+		return 0, &UnsupportedTypeError{v.Type()}
+	case setType:
+		if v.IsNil() {
+			return 0, nil
+		}
+		uid := a.add(nil)
+		refs, err := a.elements(v)
+		if err != nil {
+			return 0, err
+		}
+		return a.instance(uid, []string{"NSSet", "NSObject"}, []string{"NS.objects"}, []value{refs}), nil
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return 0, nil
+		}
+		return a.object(v.Elem())
+	case reflect.Ptr:
+		if v.IsNil() {
+			return 0, nil
+		}
+		leave, err := a.e.enter(v, 0)
+		if err != nil {
+			return 0, err
+		}
+		defer leave()
+		return a.object(v.Elem())
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return 0, nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		uid := a.add(nil)
+		refs, err := a.elements(v)
+		if err != nil {
+			return 0, err
+		}
+		return a.instance(uid, []string{"NSArray", "NSObject"}, []string{"NS.objects"}, []value{refs}), nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return 0, &UnsupportedTypeError{v.Type()}
+		}
+		if v.IsNil() {
+			return 0, nil
+		}
+		leave, err := a.e.enter(v, 0)
+		if err != nil {
+			return 0, err
+		}
+		defer leave()
+		uid := a.add(nil)
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].String() < keys[j].String()
+		})
+		var refs [2]array
+		for _, k := range keys {
+			if err := a.entry(&refs, k.String(), v.MapIndex(k)); err != nil {
+				return 0, err
+			}
+		}
+		return a.dictionary(uid, refs), nil
+	case reflect.Struct:
+		uid := a.add(nil)
+		var refs [2]array
+		for _, f := range cachedFields(v.Type()) {
+			fv := v.FieldByIndex(f.index)
+			if f.omitEmpty && isEmptyValue(fv) {
+				continue
+			}
+			if err := a.entry(&refs, f.name, fv); err != nil {
+				return 0, err
+			}
+		}
+		return a.dictionary(uid, refs), nil
+	}
+	return a.value(v)
+}
+
+// value archives v as an object stored directly in the archive.
+func (a *archiver) value(v reflect.Value) (UID, error) {
+	x, err := a.e.encode(v)
+	if err != nil {
+		return 0, err
+	}
+	if s, ok := x.(string); ok {
+		if uid, ok := a.strings[s]; ok {
+			return uid, nil
+		}
+		uid := a.add(s)
+		a.strings[s] = uid
+		return uid, nil
+	}
+	return a.add(x), nil
+}
+
+// member archives an element of a collection. Nil values are NSNull objects,
+// since collections cannot contain nil.
+func (a *archiver) member(v reflect.Value) (UID, error) {
+	uid, err := a.object(v)
+	if err != nil || uid != 0 {
+		return uid, err
+	}
+	return a.instance(a.add(nil), []string{"NSNull", "NSObject"}, nil, nil), nil
+}
+
+// elements archives the elements of the slice or array v and returns their
+// UIDs.
+func (a *archiver) elements(v reflect.Value) (array, error) {
+	if v.Kind() == reflect.Slice {
+		leave, err := a.e.enter(v, v.Len())
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+	}
+	refs := make(array, v.Len())
+	for i := range refs {
+		uid, err := a.member(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		refs[i] = uid
+	}
+	return refs, nil
+}
+
+// entry archives the key and the value of a dictionary entry and appends
+// their UIDs to refs.
+func (a *archiver) entry(refs *[2]array, key string, v reflect.Value) error {
+	k, err := a.object(reflect.ValueOf(key))
+	if err != nil {
+		return err
+	}
+	x, err := a.member(v)
+	if err != nil {
+		return err
+	}
+	refs[0] = append(refs[0], k)
+	refs[1] = append(refs[1], x)
+	return nil
+}
+
+// dictionary sets the object with the UID to an NSDictionary with the UIDs of
+// keys and values.
+func (a *archiver) dictionary(uid UID, refs [2]array) UID {
+	keys, values := refs[0], refs[1]
+	if keys == nil {
+		keys, values = array{}, array{}
+	}
+	return a.instance(uid, []string{"NSDictionary", "NSObject"}, []string{"NS.keys", "NS.objects"}, []value{keys, values})
+}
+
+// instance sets the object with the UID to an instance of the class with the
+// hierarchy classes and the given keys and values. Like NSKeyedArchiver, the
+// UID of an instance is assigned before its contents are archived.
+func (a *archiver) instance(uid UID, classes, keys []string, values []value) UID {
+	a.objects[uid] = &dict{
+		keys:   append([]string{"$class"}, keys...),
+		values: append([]value{a.class(classes)}, values...),
+	}
+	return uid
+}
+
+// class returns the UID of the class description for the hierarchy classes.
+func (a *archiver) class(classes []string) UID {
+	if uid, ok := a.classes[classes[0]]; ok {
+		return uid
+	}
+	names := make(array, len(classes))
+	for i, c := range classes {
+		names[i] = c
+	}
+	uid := a.add(&dict{
+		keys:   []string{"$classname", "$classes"},
+		values: []value{classes[0], names},
+	})
+	a.classes[classes[0]] = uid
+	return uid
+}
+
+// archivedObject archives the ArchivedObject v. Since the UID is assigned
+// before the fields are archived, fields may refer to the object itself.
+func (a *archiver) archivedObject(v reflect.Value) (UID, error) {
+	obj := v.Interface().(*ArchivedObject)
+	if uid, ok := a.instances[obj]; ok {
+		return uid, nil
+	}
+	if len(obj.Classes) == 0 {
+		return 0, &UnsupportedValueError{v, "ArchivedObject without classes"}
+	}
+	uid := a.add(nil)
+	a.instances[obj] = uid
+
+	keys := make([]string, 0, len(obj.Fields))
+	for k := range obj.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]value, len(keys))
+	for i, k := range keys {
+		x, err := a.field(reflect.ValueOf(obj.Fields[k]))
+		if err != nil {
+			return 0, err
+		}
+		values[i] = x
+	}
+	return a.instance(uid, obj.Classes, keys, values), nil
+}
+
+// field returns the value of an ArchivedObject’s field.
+func (a *archiver) field(v reflect.Value) (value, error) {
+	for v.IsValid() && v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return UID(0), nil
+	}
+	if v.Type() == uidType {
+		return nil, &UnsupportedTypeError{v.Type()}
+	}
+	switch v.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return a.e.encode(v)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return a.e.encode(v)
+		}
+		if v.Type() != setType {
+			return a.elements(v)
+		}
+	}
+	return a.object(v)
+}
@@ -0,0 +1,345 @@
+package plist_test
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/plist"
+)
+
+func mustParseURL(s string) *url.URL {
+	u, err := url.Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// foundationValue is the root object of testdata/foundation.plist.
+var foundationValue = map[string]interface{}{
+	"name":    "Example",
+	"items":   []interface{}{int64(1), "two", []byte{0, 1, 2}},
+	"created": time.Date(2022, 6, 1, 12, 34, 56, 5e8, time.UTC),
+	"url":     mustParseURL("https://example.com/a?b=c&d"),
+	"id":      plist.UUID{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+	"tags":    plist.Set{"one", "two"},
+	"mutable": "mutable ✓",
+	"null":    nil,
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	b, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestUnarchiveFoundation(t *testing.T) {
+	v, err := plist.Unarchive(readFixture(t, "foundation.plist"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(v, foundationValue) {
+		t.Errorf("expected %#v, got %#v", foundationValue, v)
+	}
+}
+
+func TestUnarchiveCycle(t *testing.T) {
+	v, err := plist.Unarchive(readFixture(t, "bookmark.plist"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, ok := v.(*plist.ArchivedObject)
+	if !ok {
+		t.Fatalf("expected *plist.ArchivedObject, got %T", v)
+	}
+	if root.Class() != "Bookmark" || !reflect.DeepEqual(root.Classes, []string{"Bookmark", "NSObject"}) {
+		t.Errorf("unexpected classes %q", root.Classes)
+	}
+	if root.Fields["title"] != "Docs" || root.Fields["count"] != int64(3) || root.Fields["parent"] != nil {
+		t.Errorf("unexpected fields %#v", root.Fields)
+	}
+	if u, ok := root.Fields["url"].(*url.URL); !ok || u.String() != "https://example.com/a/b/c" {
+		t.Errorf("expected URL resolved against its base, got %#v", root.Fields["url"])
+	}
+	children, ok := root.Fields["children"].([]interface{})
+	if !ok || len(children) != 1 {
+		t.Fatalf("unexpected children %#v", root.Fields["children"])
+	}
+	child, ok := children[0].(*plist.ArchivedObject)
+	if !ok || child.Fields["parent"] != root {
+		t.Errorf("expected child with a reference to the root object, got %#v", children[0])
+	}
+
+	// The cycle cannot be resolved if the class has a decoder.
+	u := plist.NewUnarchiver()
+	u.Register("Bookmark", func(obj *plist.ArchivedObject) (interface{}, error) {
+		return obj.Fields["title"], nil
+	})
+	_, err = u.Unarchive(readFixture(t, "bookmark.plist"))
+	if !errors.As(err, new(*plist.ArchiveError)) {
+		t.Errorf("expected ArchiveError, got %v", err)
+	}
+}
+
+type point struct {
+	X, Y float64
+}
+
+func TestUnarchiveRegister(t *testing.T) {
+	b, err := plist.Archive([]interface{}{
+		&plist.ArchivedObject{
+			Classes: []string{"Point3D", "Point", "NSObject"},
+			Fields:  map[string]interface{}{"x": 1.5, "y": -2.0, "z": 0.0},
+		},
+		mustParseURL("https://example.com"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := plist.NewUnarchiver()
+	u.Register("Point", func(obj *plist.ArchivedObject) (interface{}, error) {
+		x, _ := obj.Fields["x"].(float64)
+		y, _ := obj.Fields["y"].(float64)
+		return point{x, y}, nil
+	})
+	u.Register("NSURL", func(obj *plist.ArchivedObject) (interface{}, error) {
+		return obj.Fields["NS.relative"], nil
+	})
+	v, err := u.Unarchive(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []interface{}{point{1.5, -2}, "https://example.com"}
+	if !reflect.DeepEqual(v, expected) {
+		t.Errorf("expected %#v, got %#v", expected, v)
+	}
+
+	// Without decoders, the objects are ArchivedObject values.
+	v, err = new(plist.Unarchiver).Unarchive(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, ok := v.(*plist.ArchivedObject); !ok || s.Class() != "NSArray" {
+		t.Errorf("expected NSArray object, got %#v", v)
+	}
+}
+
+func TestArchive(t *testing.T) {
+	type entry struct {
+		Name  string   `plist:"name"`
+		Count int      `plist:"count,omitempty"`
+		Tags  []string `plist:"tags"`
+	}
+	b, err := plist.Archive(map[string]interface{}{
+		"entry": entry{Name: "a", Tags: []string{"a", "b"}},
+		"list":  []interface{}{nil, true, 1.5, "a"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := plist.Unarchive(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"entry": map[string]interface{}{"name": "a", "tags": []interface{}{"a", "b"}},
+		"list":  []interface{}{nil, true, 1.5, "a"},
+	}
+	if !reflect.DeepEqual(v, expected) {
+		t.Errorf("expected %#v, got %#v", expected, v)
+	}
+
+	// Check the structure that NSKeyedUnarchiver expects.
+	var archive struct {
+		Version  int64                `plist:"$version"`
+		Archiver string               `plist:"$archiver"`
+		Top      map[string]plist.UID `plist:"$top"`
+		Objects  []interface{}        `plist:"$objects"`
+	}
+	format, err := plist.Unmarshal(b, &archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != plist.BinaryFormat {
+		t.Errorf("expected binary format, got %v", format)
+	}
+	if archive.Version != 100000 || archive.Archiver != "NSKeyedArchiver" {
+		t.Errorf("unexpected $version %d and $archiver %q", archive.Version, archive.Archiver)
+	}
+	if len(archive.Top) != 1 || archive.Top["root"] != 1 {
+		t.Errorf("unexpected $top %v", archive.Top)
+	}
+	if len(archive.Objects) == 0 || archive.Objects[0] != "$null" {
+		t.Fatalf("expected $null as the first object, got %#v", archive.Objects)
+	}
+	strings := make(map[string]int)
+	classes := make(map[string]int)
+	for _, obj := range archive.Objects {
+		switch obj := obj.(type) {
+		case string:
+			strings[obj]++
+		case map[string]interface{}:
+			if name, ok := obj["$classname"].(string); ok {
+				classes[name]++
+				if c, ok := obj["$classes"].([]interface{}); !ok || len(c) == 0 || c[0] != name {
+					t.Errorf("unexpected $classes %#v", obj["$classes"])
+				}
+			} else if _, ok := obj["$class"].(plist.UID); !ok {
+				t.Errorf("object without $class %#v", obj)
+			}
+		}
+	}
+	for s, n := range strings {
+		if n != 1 {
+			t.Errorf("string %q archived %d times", s, n)
+		}
+	}
+	for c, n := range classes {
+		if n != 1 {
+			t.Errorf("class %q described %d times", c, n)
+		}
+	}
+	if len(classes) != 3 {
+		t.Errorf("expected NSDictionary, NSArray and NSNull classes, got %v", classes)
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	b, err := plist.Archive(foundationValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := plist.Unarchive(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(v, foundationValue) {
+		t.Errorf("expected %#v, got %#v", foundationValue, v)
+	}
+
+	v, err = plist.Unarchive(readFixture(t, "bookmark.plist"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err = plist.Archive(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err = plist.Unarchive(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := v.(*plist.ArchivedObject)
+	child := root.Fields["children"].([]interface{})[0].(*plist.ArchivedObject)
+	if child.Fields["parent"] != root || child.Fields["title"] != "Child" {
+		t.Errorf("cycle did not round-trip: %#v", child.Fields)
+	}
+}
+
+func TestArchiveShared(t *testing.T) {
+	// Each level refers to the previous one twice.
+	v := []interface{}{"x"}
+	for i := 0; i < 64; i++ {
+		v = []interface{}{v, v}
+	}
+	b, err := plist.Archive(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var archive struct {
+		Objects []interface{} `plist:"$objects"`
+	}
+	if _, err := plist.Unmarshal(b, &archive); err != nil {
+		t.Fatal(err)
+	}
+	// $null, 65 arrays, "x" and the NSArray class.
+	if n := len(archive.Objects); n != 68 {
+		t.Errorf("expected 68 objects, got %d", n)
+	}
+	if _, err := plist.Unarchive(b); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArchiveErrors(t *testing.T) {
+	cyclic := map[string]interface{}{}
+	cyclic["self"] = cyclic
+	tests := []struct {
+		name  string
+		value interface{}
+		err   interface{}
+	}{
+		{"UID", plist.UID(1), new(*plist.UnsupportedTypeError)},
+		{"channel", []interface{}{make(chan int)}, new(*plist.UnsupportedTypeError)},
+		{"map key", map[int]string{}, new(*plist.UnsupportedTypeError)},
+		{"cycle", cyclic, new(*plist.UnsupportedValueError)},
+		{"classes", &plist.ArchivedObject{}, new(*plist.UnsupportedValueError)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := plist.Archive(tt.value)
+			if !errors.As(err, tt.err) {
+				t.Errorf("expected %T, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestUnarchiveErrors(t *testing.T) {
+	archive := func(objects ...interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"$archiver": "NSKeyedArchiver",
+			"$version":  100000,
+			"$top":      map[string]interface{}{"root": plist.UID(1)},
+			"$objects":  append([]interface{}{"$null"}, objects...),
+		}
+	}
+	class := func(name string) map[string]interface{} {
+		return map[string]interface{}{"$classname": name, "$classes": []string{name, "NSObject"}}
+	}
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"root", []interface{}{}},
+		{"objects", map[string]interface{}{"$top": map[string]interface{}{"root": plist.UID(1)}}},
+		{"top", map[string]interface{}{"$objects": []interface{}{"$null"}}},
+		{"reference", archive()},
+		{"class", archive(map[string]interface{}{"NS.objects": []interface{}{}})},
+		{"class reference", archive(map[string]interface{}{"$class": plist.UID(5)})},
+		{"class name", archive(map[string]interface{}{"$class": plist.UID(2)}, map[string]interface{}{})},
+		{"UID object", archive(plist.UID(0))},
+		{"cycle", archive(map[string]interface{}{"$class": plist.UID(2), "NS.objects": []interface{}{plist.UID(1)}}, class("NSArray"))},
+		{"array", archive(map[string]interface{}{"$class": plist.UID(2)}, class("NSArray"))},
+		{"keys", archive(map[string]interface{}{"$class": plist.UID(2), "NS.keys": []interface{}{}, "NS.objects": []interface{}{plist.UID(0)}}, class("NSDictionary"))},
+		{"key", archive(map[string]interface{}{"$class": plist.UID(2), "NS.keys": []interface{}{plist.UID(0)}, "NS.objects": []interface{}{plist.UID(0)}}, class("NSDictionary"))},
+		{"UUID", archive(map[string]interface{}{"$class": plist.UID(2), "NS.uuidbytes": []byte{0}}, class("NSUUID"))},
+		{"date", archive(map[string]interface{}{"$class": plist.UID(2), "NS.time": 1e300}, class("NSDate"))},
+		{"URL", archive(map[string]interface{}{"$class": plist.UID(2), "NS.relative": "%"}, class("NSURL"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := plist.Marshal(tt.value, plist.BinaryFormat)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = plist.Unarchive(b)
+			if !errors.As(err, new(*plist.ArchiveError)) {
+				t.Errorf("expected ArchiveError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUUIDString(t *testing.T) {
+	s := foundationValue["id"].(plist.UUID).String()
+	if s != "00112233-4455-6677-8899-AABBCCDDEEFF" {
+		t.Errorf("unexpected UUID string %q", s)
+	}
+}
@@ -0,0 +1,573 @@
+package plist
+
+// This file implements the binary property list format.
+//
+// A binary property list consists of a header, a list of objects, an offset
+// table with the offset of each object and a trailer that describes the size
+// of integers in the offset table and of object references. Containers refer
+// to their values using indexes in the offset table, which allows writers to
+// store equal strings, numbers and other values once.
+//
+// References
+//  • https://opensource.apple.com/source/CF/CF-1153.18/CFBinaryPList.c
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	binaryMagic = "bplist00"
+	trailerSize = 32
+)
+
+// Object type markers. The low four bits of a marker are the size or the
+// number of elements of the object.
+const (
+	markerNull   = 0x00
+	markerFalse  = 0x08
+	markerTrue   = 0x09
+	markerInt    = 0x10
+	markerReal   = 0x20
+	markerDate   = 0x33
+	markerData   = 0x40
+	markerASCII  = 0x50
+	markerUTF16  = 0x60
+	markerUID    = 0x80
+	markerArray  = 0xA0
+	markerSet    = 0xC0
+	markerDict   = 0xD0
+	markerLength = 0x0F // length follows as an integer object
+)
+
+// maxDepth is the maximum nesting depth of containers in property lists that
+// are read.
+const maxDepth = 512
+
+// maxExpansion limits the number of objects that a binary property list may
+// expand to per input byte. Containers may refer to the same object many
+// times, so a small input can otherwise describe a huge object graph.
+const maxExpansion = 16
+
+// binaryObject is an object in the object table of a binary property list.
+type binaryObject struct {
+	v    value
+	refs []int // object indexes of container values or dictionary keys and values
+}
+
+// dedupKey identifies an object that is stored once.
+type dedupKey struct {
+	marker byte
+	s      string
+	i      integer
+}
+
+// binaryWriter writes binary property lists.
+type binaryWriter struct {
+	objects []binaryObject
+	dedup   map[dedupKey]int
+}
+
+// writeBinary writes the property list with the root object v.
+func writeBinary(buf *bytes.Buffer, v value) {
+	w := binaryWriter{dedup: make(map[dedupKey]int)}
+	w.add(v)
+
+	refSize := byteSize(uint64(len(w.objects)))
+	offsets := make([]uint64, len(w.objects))
+	buf.WriteString(binaryMagic)
+	for i, obj := range w.objects {
+		offsets[i] = uint64(buf.Len())
+		writeObject(buf, obj, refSize)
+	}
+
+	offsetTable := uint64(buf.Len())
+	offsetSize := byteSize(offsetTable)
+	for _, off := range offsets {
+		writeUint(buf, off, offsetSize)
+	}
+
+	var trailer [trailerSize]byte
+	trailer[6] = byte(offsetSize)
+	trailer[7] = byte(refSize)
+	binary.BigEndian.PutUint64(trailer[8:], uint64(len(w.objects)))
+	binary.BigEndian.PutUint64(trailer[16:], 0)
+	binary.BigEndian.PutUint64(trailer[24:], offsetTable)
+	buf.Write(trailer[:])
+}
+
+// add adds the object and its values to the object table and returns its
+// index. Objects other than containers are stored once.
+func (w *binaryWriter) add(v value) int {
+	var refs []value
+	switch v := v.(type) {
+	case array:
+		refs = v
+	case set:
+		refs = v
+	case *dict:
+		refs = make([]value, 0, 2*len(v.keys))
+		for _, k := range v.keys {
+			refs = append(refs, k)
+		}
+		refs = append(refs, v.values...)
+	default:
+		k := dedupKeyOf(v)
+		if i, ok := w.dedup[k]; ok {
+			return i
+		}
+		w.dedup[k] = len(w.objects)
+		w.objects = append(w.objects, binaryObject{v: v})
+		return len(w.objects) - 1
+	}
+	i := len(w.objects)
+	w.objects = append(w.objects, binaryObject{v: v})
+	indexes := make([]int, len(refs))
+	for j, x := range refs {
+		indexes[j] = w.add(x)
+	}
+	w.objects[i].refs = indexes
+	return i
+}
+
+// dedupKeyOf returns the key of an object other than a container.
+func dedupKeyOf(v value) dedupKey {
+	switch v := v.(type) {
+	case string:
+		return dedupKey{marker: markerASCII, s: v}
+	case []byte:
+		return dedupKey{marker: markerData, s: string(v)}
+	case bool:
+		if v {
+			return dedupKey{marker: markerTrue}
+		}
+		return dedupKey{marker: markerFalse}
+	case integer:
+		return dedupKey{marker: markerInt, i: v}
+	case real:
+		k := dedupKey{marker: markerReal | 3, i: uintOf(math.Float64bits(v.v))}
+		if v.single {
+			k.marker = markerReal | 2
+		}
+		return k
+	case time.Time:
+		return dedupKey{marker: markerDate, i: uintOf(math.Float64bits(absoluteTimeOf(v)))}
+	case UID:
+		return dedupKey{marker: markerUID, i: uintOf(uint64(v))}
+	}
+	return dedupKey{marker: markerNull}
+}
+
+// writeObject writes the object using refSize bytes for object references.
+func writeObject(buf *bytes.Buffer, obj binaryObject, refSize int) {
+	switch v := obj.v.(type) {
+	case nil:
+		buf.WriteByte(markerNull)
+	case bool:
+		if v {
+			buf.WriteByte(markerTrue)
+		} else {
+			buf.WriteByte(markerFalse)
+		}
+	case integer:
+		writeInteger(buf, v)
+	case real:
+		if v.single {
+			buf.WriteByte(markerReal | 2)
+			writeUint(buf, uint64(math.Float32bits(float32(v.v))), 4)
+		} else {
+			buf.WriteByte(markerReal | 3)
+			writeUint(buf, math.Float64bits(v.v), 8)
+		}
+	case time.Time:
+		buf.WriteByte(markerDate)
+		writeUint(buf, math.Float64bits(absoluteTimeOf(v)), 8)
+	case []byte:
+		writeHeader(buf, markerData, len(v))
+		buf.Write(v)
+	case string:
+		if isASCII(v) {
+			writeHeader(buf, markerASCII, len(v))
+			buf.WriteString(v)
+			break
+		}
+		s := utf16.Encode([]rune(v))
+		writeHeader(buf, markerUTF16, len(s))
+		for _, c := range s {
+			writeUint(buf, uint64(c), 2)
+		}
+	case UID:
+		n := byteSize(uint64(v))
+		buf.WriteByte(markerUID | byte(n-1))
+		writeUint(buf, uint64(v), n)
+	case array:
+		writeHeader(buf, markerArray, len(obj.refs))
+		writeRefs(buf, obj.refs, refSize)
+	case set:
+		writeHeader(buf, markerSet, len(obj.refs))
+		writeRefs(buf, obj.refs, refSize)
+	case *dict:
+		writeHeader(buf, markerDict, len(obj.refs)/2)
+		writeRefs(buf, obj.refs, refSize)
+	}
+}
+
+// writeInteger writes an integer object. Non-negative values are stored in
+// the smallest of 1, 2, 4 or 8 bytes, negative values in 8 bytes, and values
+// that do not fit in int64 in 16 bytes.
+func writeInteger(buf *bytes.Buffer, i integer) {
+	if u, ok := i.uint64(); ok && u <= math.MaxUint32 {
+		n := byteSize(u)
+		buf.WriteByte(markerInt | log2(n))
+		writeUint(buf, u, n)
+		return
+	}
+	if _, ok := i.int64(); ok {
+		buf.WriteByte(markerInt | 3)
+		writeUint(buf, i.lo, 8)
+		return
+	}
+	buf.WriteByte(markerInt | 4)
+	writeUint(buf, i.hi, 8)
+	writeUint(buf, i.lo, 8)
+}
+
+// writeHeader writes the marker byte of an object with n bytes or elements.
+func writeHeader(buf *bytes.Buffer, marker byte, n int) {
+	if n < markerLength {
+		buf.WriteByte(marker | byte(n))
+		return
+	}
+	buf.WriteByte(marker | markerLength)
+	writeInteger(buf, intOf(int64(n)))
+}
+
+func writeRefs(buf *bytes.Buffer, refs []int, refSize int) {
+	for _, ref := range refs {
+		writeUint(buf, uint64(ref), refSize)
+	}
+}
+
+// writeUint writes the n low-order bytes of v in big-endian byte order.
+func writeUint(buf *bytes.Buffer, v uint64, n int) {
+	for i := n - 1; i >= 0; i-- {
+		buf.WriteByte(byte(v >> (8 * i)))
+	}
+}
+
+// byteSize returns the smallest of 1, 2, 4 and 8 that is the number of bytes
+// needed to store v.
+func byteSize(v uint64) int {
+	switch {
+	case v <= math.MaxUint8:
+		return 1
+	case v <= math.MaxUint16:
+		return 2
+	case v <= math.MaxUint32:
+		return 4
+	}
+	return 8
+}
+
+// log2 returns the base 2 logarithm of the power of two n.
+func log2(n int) byte {
+	var i byte
+	for n > 1 {
+		n >>= 1
+		i++
+	}
+	return i
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// binaryReader reads binary property lists.
+type binaryReader struct {
+	data        []byte
+	offsetSize  int
+	refSize     int
+	numObjects  uint64
+	offsetTable uint64
+
+	objects []value
+	// state is 0 for objects that were not read, 1 for objects that are
+	// being read and 2 for objects that were read.
+	state []uint8
+	// sizes are the number of objects that read objects expand to.
+	sizes []uint64
+	// maxSize is the maximum number of objects that the root may expand to.
+	maxSize uint64
+	depth   int
+}
+
+// readBinary reads a binary property list and returns the root object.
+func readBinary(data []byte) (value, error) {
+	if len(data) < len(binaryMagic)+trailerSize {
+		return nil, errBinary("input is too short")
+	}
+	trailer := data[len(data)-trailerSize:]
+	r := binaryReader{
+		data:        data,
+		offsetSize:  int(trailer[6]),
+		refSize:     int(trailer[7]),
+		numObjects:  binary.BigEndian.Uint64(trailer[8:]),
+		offsetTable: binary.BigEndian.Uint64(trailer[24:]),
+	}
+	top := binary.BigEndian.Uint64(trailer[16:])
+	if r.offsetSize < 1 || r.offsetSize > 8 || r.refSize < 1 || r.refSize > 8 {
+		return nil, errBinary("invalid integer size in trailer")
+	}
+	end := uint64(len(data) - trailerSize)
+	if r.offsetTable < uint64(len(binaryMagic)) || r.offsetTable > end {
+		return nil, errBinary("offset table is out of bounds")
+	}
+	if r.numObjects == 0 || r.numObjects > (end-r.offsetTable)/uint64(r.offsetSize) {
+		return nil, errBinary("invalid number of objects")
+	}
+	if top >= r.numObjects {
+		return nil, errBinary("top object is out of bounds")
+	}
+	r.objects = make([]value, r.numObjects)
+	r.state = make([]uint8, r.numObjects)
+	r.sizes = make([]uint64, r.numObjects)
+	r.maxSize = uint64(len(data)) * maxExpansion
+	return r.object(top)
+}
+
+func errBinary(msg string) error {
+	return &FormatError{BinaryFormat, msg}
+}
+
+// object returns the object with the index i in the offset table.
+func (r *binaryReader) object(i uint64) (value, error) {
+	switch r.state[i] {
+	case 1:
+		return nil, errBinary("cyclic object reference")
+	case 2:
+		return r.objects[i], nil
+	}
+	r.state[i] = 1
+	off := readUint(r.data[r.offsetTable+i*uint64(r.offsetSize):], r.offsetSize)
+	if off < uint64(len(binaryMagic)) || off >= r.offsetTable {
+		return nil, errBinary("object offset is out of bounds")
+	}
+	v, size, err := r.read(off)
+	if err != nil {
+		return nil, err
+	}
+	r.objects[i], r.sizes[i], r.state[i] = v, size, 2
+	return v, nil
+}
+
+// read reads the object at the offset off and returns it and the number of
+// objects that it expands to.
+func (r *binaryReader) read(off uint64) (value, uint64, error) {
+	marker := r.data[off]
+	buf := r.data[off+1 : r.offsetTable]
+	n := int(marker & 0x0F)
+	switch marker & 0xF0 {
+	case 0x00:
+		switch marker {
+		case markerNull:
+			return nil, 1, nil
+		case markerFalse:
+			return false, 1, nil
+		case markerTrue:
+			return true, 1, nil
+		}
+	case markerInt:
+		i, _, err := readInteger(buf, n)
+		return i, 1, err
+	case markerReal:
+		switch n {
+		case 2:
+			if len(buf) < 4 {
+				break
+			}
+			return real{float64(math.Float32frombits(uint32(readUint(buf, 4)))), true}, 1, nil
+		case 3:
+			if len(buf) < 8 {
+				break
+			}
+			return real{math.Float64frombits(readUint(buf, 8)), false}, 1, nil
+		}
+		return nil, 0, errBinary("invalid real")
+	case markerDate & 0xF0:
+		if marker != markerDate || len(buf) < 8 {
+			break
+		}
+		t, ok := timeOf(math.Float64frombits(readUint(buf, 8)))
+		if !ok {
+			return nil, 0, errBinary("date is out of range")
+		}
+		return t, 1, nil
+	case markerData:
+		b, err := r.bytes(buf, n, 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		return append([]byte{}, b...), 1, nil
+	case markerASCII:
+		b, err := r.bytes(buf, n, 1)
+		if err != nil {
+			return nil, 0, err
+		}
+		if isASCII(string(b)) {
+			return string(b), 1, nil
+		}
+		// Interpret other bytes as Latin-1 rather than producing
+		// invalid UTF-8.
+		s := make([]rune, len(b))
+		for i, c := range b {
+			s[i] = rune(c)
+		}
+		return string(s), 1, nil
+	case markerUTF16:
+		b, err := r.bytes(buf, n, 2)
+		if err != nil {
+			return nil, 0, err
+		}
+		s := make([]uint16, len(b)/2)
+		for i := range s {
+			s[i] = binary.BigEndian.Uint16(b[2*i:])
+		}
+		return string(utf16.Decode(s)), 1, nil
+	case markerUID:
+		if n >= 8 || len(buf) < n+1 {
+			break
+		}
+		return UID(readUint(buf, n+1)), 1, nil
+	case markerArray, markerSet, markerDict:
+		return r.container(marker, buf, n)
+	}
+	return nil, 0, errBinary("invalid object marker")
+}
+
+// bytes returns the bytes of an object with n elements of the given size,
+// where buf follows the marker of the object.
+func (r *binaryReader) bytes(buf []byte, n, size int) ([]byte, error) {
+	count, buf, err := readCount(buf, n)
+	if err != nil {
+		return nil, err
+	}
+	if count > uint64(len(buf)/size) {
+		return nil, errBinary("object is out of bounds")
+	}
+	return buf[:count*uint64(size)], nil
+}
+
+// container reads an array, a set or a dictionary.
+func (r *binaryReader) container(marker byte, buf []byte, n int) (value, uint64, error) {
+	count, buf, err := readCount(buf, n)
+	if err != nil {
+		return nil, 0, err
+	}
+	numRefs := count
+	if marker&0xF0 == markerDict {
+		if count > math.MaxUint64/2 {
+			return nil, 0, errBinary("object is out of bounds")
+		}
+		numRefs = 2 * count
+	}
+	if numRefs > uint64(len(buf)/r.refSize) {
+		return nil, 0, errBinary("object is out of bounds")
+	}
+	if r.depth >= maxDepth {
+		return nil, 0, errBinary("exceeded maximum nesting depth")
+	}
+	r.depth++
+	defer func() { r.depth-- }()
+
+	values := make([]value, numRefs)
+	size := uint64(1)
+	for i := range values {
+		ref := readUint(buf[i*r.refSize:], r.refSize)
+		if ref >= r.numObjects {
+			return nil, 0, errBinary("object reference is out of bounds")
+		}
+		v, err := r.object(ref)
+		if err != nil {
+			return nil, 0, err
+		}
+		values[i] = v
+		size += r.sizes[ref]
+		if size > r.maxSize {
+			return nil, 0, errBinary("object graph is too large")
+		}
+	}
+
+	switch marker & 0xF0 {
+	case markerArray:
+		return array(values), size, nil
+	case markerSet:
+		return set(values), size, nil
+	}
+	d := &dict{keys: make([]string, count), values: values[count:]}
+	for i := range d.keys {
+		k, ok := values[i].(string)
+		if !ok {
+			return nil, 0, errBinary("dictionary key is not a string")
+		}
+		d.keys[i] = k
+	}
+	return d, size, nil
+}
+
+// readCount returns the number of elements of an object with the low four
+// bits of the marker n and the bytes that follow the count.
+func readCount(buf []byte, n int) (uint64, []byte, error) {
+	if n != markerLength {
+		return uint64(n), buf, nil
+	}
+	if len(buf) == 0 || buf[0]&0xF0 != markerInt {
+		return 0, nil, errBinary("invalid object length")
+	}
+	i, size, err := readInteger(buf[1:], int(buf[0]&0x0F))
+	if err != nil {
+		return 0, nil, err
+	}
+	count, ok := i.uint64()
+	if !ok {
+		return 0, nil, errBinary("invalid object length")
+	}
+	return count, buf[1+size:], nil
+}
+
+// readInteger reads an integer of 2ⁿ bytes and returns it with its size.
+// Integers of 1, 2 and 4 bytes are unsigned, of 8 bytes signed, and of 16
+// bytes signed 128-bit integers.
+func readInteger(buf []byte, n int) (integer, int, error) {
+	if n > 4 {
+		return integer{}, 0, errBinary("invalid integer size")
+	}
+	size := 1 << n
+	if len(buf) < size {
+		return integer{}, 0, errBinary("integer is out of bounds")
+	}
+	switch size {
+	case 16:
+		return integer{readUint(buf, 8), readUint(buf[8:], 8)}, size, nil
+	case 8:
+		return intOf(int64(readUint(buf, 8))), size, nil
+	}
+	return uintOf(readUint(buf, size)), size, nil
+}
+
+// readUint reads an n-byte big-endian unsigned integer.
+func readUint(buf []byte, n int) uint64 {
+	var v uint64
+	for _, b := range buf[:n] {
+		v = v<<8 | uint64(b)
+	}
+	return v
+}
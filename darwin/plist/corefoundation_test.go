@@ -0,0 +1,88 @@
+//go:build darwin
+// +build darwin
+
+package plist_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/corefoundation"
+	"github.com/noncgo/x/darwin/plist"
+)
+
+// crossCheckValue contains values that both packages convert to the same Go
+// values.
+var crossCheckValue = map[string]interface{}{
+	"string": "example ✓",
+	"ascii":  "a & b",
+	"data":   []byte{0, 1, 2},
+	"bool":   true,
+	"int":    int64(-42),
+	"large":  int64(1) << 40,
+	"real":   0.1,
+	"date":   time.Date(2022, 6, 1, 12, 34, 56, 0, time.UTC),
+	"array":  []interface{}{"a", "a", int64(1)},
+	"dict":   map[string]interface{}{"nested": []interface{}{}},
+	"long":   []interface{}{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15"},
+}
+
+// inUTC returns v with dates in UTC, since corefoundation.ToGo returns dates in
+// local time.
+func inUTC(v interface{}) interface{} {
+	switch v := v.(type) {
+	case time.Time:
+		return v.UTC()
+	case []interface{}:
+		for i := range v {
+			v[i] = inUTC(v[i])
+		}
+	case map[string]interface{}:
+		for k := range v {
+			v[k] = inUTC(v[k])
+		}
+	}
+	return v
+}
+
+func TestCrossCheckCoreFoundation(t *testing.T) {
+	formats := []struct {
+		plist plist.Format
+		cf    corefoundation.PropertyListFormat
+	}{
+		{plist.XMLFormat, corefoundation.PropertyListXMLFormat},
+		{plist.BinaryFormat, corefoundation.PropertyListBinaryFormat},
+	}
+	for _, f := range formats {
+		t.Run(f.plist.String(), func(t *testing.T) {
+			b, err := plist.Marshal(crossCheckValue, f.plist)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := corefoundation.Unmarshal(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got = inUTC(got); !reflect.DeepEqual(got, crossCheckValue) {
+				t.Errorf("CFPropertyListCreateWithData: expected %#v, got %#v", crossCheckValue, got)
+			}
+
+			b, err = corefoundation.Marshal(crossCheckValue, f.cf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var v interface{}
+			format, err := plist.Unmarshal(b, &v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if format != f.plist {
+				t.Errorf("expected %v format, got %v", f.plist, format)
+			}
+			if !reflect.DeepEqual(v, crossCheckValue) {
+				t.Errorf("CFPropertyListCreateData: expected %#v, got %#v", crossCheckValue, v)
+			}
+		})
+	}
+}
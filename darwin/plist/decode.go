@@ -0,0 +1,248 @@
+package plist
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// decoder stores property list objects in Go values.
+type decoder struct {
+	// err is the first UnmarshalTypeError.
+	err error
+	// path is the path to the current struct field.
+	path []string
+}
+
+// typeError records an UnmarshalTypeError for the object v and the target
+// type t, unless there is already an error.
+func (d *decoder) typeError(v value, t reflect.Type) {
+	if d.err == nil {
+		d.err = &UnmarshalTypeError{kindName(v), t, strings.Join(d.path, ".")}
+	}
+}
+
+// decode stores the object v in rv.
+func (d *decoder) decode(v value, rv reflect.Value) {
+	if v == nil {
+		rv.Set(reflect.Zero(rv.Type()))
+		return
+	}
+	switch rv.Type() {
+	case timeType:
+		if t, ok := v.(time.Time); ok {
+			rv.Set(reflect.ValueOf(t))
+		} else {
+			d.typeError(v, rv.Type())
+		}
+		return
+	case bigIntType:
+		if i, ok := v.(integer); ok {
+			rv.Set(reflect.ValueOf(i.big()))
+		} else {
+			d.typeError(v, rv.Type())
+		}
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		if rv.NumMethod() == 0 {
+			if x := toInterface(v); x != nil {
+				rv.Set(reflect.ValueOf(x))
+			} else {
+				rv.Set(reflect.Zero(rv.Type()))
+			}
+			return
+		}
+		if !rv.IsNil() && rv.Elem().Kind() == reflect.Ptr && !rv.Elem().IsNil() {
+			d.decode(v, rv.Elem().Elem())
+			return
+		}
+		d.typeError(v, rv.Type())
+		return
+	case reflect.Ptr:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		d.decode(v, rv.Elem())
+		return
+	}
+
+	switch v := v.(type) {
+	case string:
+		if rv.Kind() == reflect.String {
+			rv.SetString(v)
+			return
+		}
+	case []byte:
+		switch {
+		case rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.Uint8:
+			b := reflect.MakeSlice(rv.Type(), len(v), len(v))
+			reflect.Copy(b, reflect.ValueOf(v))
+			rv.Set(b)
+			return
+		case rv.Kind() == reflect.Array && rv.Type().Elem().Kind() == reflect.Uint8:
+			for i := 0; i < rv.Len(); i++ {
+				var x uint64
+				if i < len(v) {
+					x = uint64(v[i])
+				}
+				rv.Index(i).SetUint(x)
+			}
+			return
+		}
+	case bool:
+		if rv.Kind() == reflect.Bool {
+			rv.SetBool(v)
+			return
+		}
+	case integer:
+		if d.integer(v, rv) {
+			return
+		}
+	case UID:
+		if d.integer(uintOf(uint64(v)), rv) {
+			return
+		}
+	case real:
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			if !rv.OverflowFloat(v.v) {
+				rv.SetFloat(v.v)
+				return
+			}
+		}
+	case array:
+		if d.array(v, rv) {
+			return
+		}
+	case set:
+		if d.array(v, rv) {
+			return
+		}
+	case *dict:
+		switch rv.Kind() {
+		case reflect.Map:
+			if rv.Type().Key().Kind() == reflect.String {
+				d.dictionary(v, rv)
+				return
+			}
+		case reflect.Struct:
+			d.structure(v, rv)
+			return
+		}
+	}
+	d.typeError(v, rv.Type())
+}
+
+// integer stores the integer in a numeric value and reports whether it fits.
+func (d *decoder) integer(i integer, rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		x, ok := i.int64()
+		if ok && !rv.OverflowInt(x) {
+			rv.SetInt(x)
+			return true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		x, ok := i.uint64()
+		if ok && !rv.OverflowUint(x) {
+			rv.SetUint(x)
+			return true
+		}
+	case reflect.Float32, reflect.Float64:
+		rv.SetFloat(i.float64())
+		return true
+	}
+	return false
+}
+
+// array stores the values of an array or a set in a slice or an array and
+// reports whether the target is a slice or an array.
+func (d *decoder) array(values []value, rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Slice:
+		s := reflect.MakeSlice(rv.Type(), len(values), len(values))
+		for i, x := range values {
+			d.decode(x, s.Index(i))
+		}
+		rv.Set(s)
+		return true
+	case reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if i < len(values) {
+				d.decode(values[i], rv.Index(i))
+			} else {
+				rv.Index(i).Set(reflect.Zero(rv.Type().Elem()))
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// dictionary stores the dictionary in a map with string keys.
+func (d *decoder) dictionary(v *dict, rv reflect.Value) {
+	t := rv.Type()
+	if rv.IsNil() {
+		rv.Set(reflect.MakeMapWithSize(t, len(v.keys)))
+	}
+	for i, k := range v.keys {
+		x := reflect.New(t.Elem()).Elem()
+		d.decode(v.values[i], x)
+		rv.SetMapIndex(reflect.ValueOf(k).Convert(t.Key()), x)
+	}
+}
+
+// structure stores the dictionary in the fields of a struct.
+func (d *decoder) structure(v *dict, rv reflect.Value) {
+	fields := cachedFields(rv.Type())
+	for i, k := range v.keys {
+		f, ok := lookupField(fields, k)
+		if !ok {
+			continue
+		}
+		d.path = append(d.path, f.name)
+		d.decode(v.values[i], rv.FieldByIndex(f.index))
+		d.path = d.path[:len(d.path)-1]
+	}
+}
+
+// toInterface returns the Go value of the object for an empty interface.
+func toInterface(v value) interface{} {
+	switch v := v.(type) {
+	case integer:
+		if x, ok := v.int64(); ok {
+			return x
+		}
+		if x, ok := v.uint64(); ok {
+			return x
+		}
+		return v.big()
+	case real:
+		return v.v
+	case []byte:
+		return append([]byte{}, v...)
+	case array:
+		return toInterfaces(v)
+	case set:
+		return toInterfaces(v)
+	case *dict:
+		m := make(map[string]interface{}, len(v.keys))
+		for i, k := range v.keys {
+			m[k] = toInterface(v.values[i])
+		}
+		return m
+	}
+	// string, bool, time.Time, UID or nil.
+	return v
+}
+
+func toInterfaces(values []value) []interface{} {
+	s := make([]interface{}, len(values))
+	for i, x := range values {
+		s[i] = toInterface(x)
+	}
+	return s
+}
@@ -0,0 +1,221 @@
+package plist
+
+import (
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	timeType   = reflect.TypeOf(time.Time{})
+	bigIntType = reflect.TypeOf((*big.Int)(nil))
+)
+
+// visitKey identifies a pointer, map or slice value for cycle detection.
+type visitKey struct {
+	ptr uintptr
+	typ reflect.Type
+	len int
+}
+
+// encoder converts Go values to property list objects.
+type encoder struct {
+	format Format
+	// visiting is the set of pointers, maps and slices on the path from
+	// the root value.
+	visiting map[visitKey]bool
+}
+
+// encode returns the property list object for v. It returns nil value without
+// an error if v is nil, i.e. the value should be omitted.
+func (e *encoder) encode(v reflect.Value) (value, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	switch v.Type() {
+	case timeType:
+		t := v.Interface().(time.Time)
+		if y := t.UTC().Year(); e.format == XMLFormat && (y < 0 || y > 9999) {
+			return nil, &UnsupportedValueError{v, "date " + t.String() + " in XML"}
+		}
+		return t, nil
+	case uidType:
+		return UID(v.Uint()), nil
+	case bigIntType:
+		if v.IsNil() {
+			return nil, nil
+		}
+		i, ok := bigOf(v.Interface().(*big.Int))
+		if !ok {
+			return nil, &UnsupportedValueError{v, "integer " + v.Interface().(*big.Int).String() + " overflows 128 bits"}
+		}
+		return i, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return e.encode(v.Elem())
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		leave, err := e.enter(v, 0)
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		return e.encode(v.Elem())
+	case reflect.String:
+		return e.string(v, v.String())
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return intOf(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uintOf(v.Uint()), nil
+	case reflect.Float32:
+		return real{v.Float(), true}, nil
+	case reflect.Float64:
+		return real{v.Float(), false}, nil
+	case reflect.Slice:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return append([]byte{}, v.Bytes()...), nil
+		}
+		leave, err := e.enter(v, v.Len())
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		values, err := e.array(v)
+		if err != nil {
+			return nil, err
+		}
+		if v.Type() == setType {
+			return set(values), nil
+		}
+		return values, nil
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, v.Len())
+			for i := range b {
+				b[i] = byte(v.Index(i).Uint())
+			}
+			return b, nil
+		}
+		return e.array(v)
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, &UnsupportedTypeError{v.Type()}
+		}
+		if v.IsNil() {
+			return nil, nil
+		}
+		leave, err := e.enter(v, 0)
+		if err != nil {
+			return nil, err
+		}
+		defer leave()
+		return e.dictionary(v)
+	case reflect.Struct:
+		d := &dict{}
+		if err := e.fields(d, v); err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+	return nil, &UnsupportedTypeError{v.Type()}
+}
+
+// enter marks the pointer, map or slice v as being converted and returns a
+// function that unmarks it. It returns UnsupportedValueError if v is already
+// being converted, i.e. the data structure is cyclic.
+func (e *encoder) enter(v reflect.Value, n int) (func(), error) {
+	k := visitKey{v.Pointer(), v.Type(), n}
+	if e.visiting[k] {
+		return nil, &UnsupportedValueError{v, "encountered a cycle via " + v.Type().String()}
+	}
+	e.visiting[k] = true
+	return func() { delete(e.visiting, k) }, nil
+}
+
+// string returns the string object for s, replacing invalid UTF-8. Strings
+// with characters that are not allowed in XML documents are an error in the
+// XML format.
+func (e *encoder) string(v reflect.Value, s string) (value, error) {
+	s = strings.ToValidUTF8(s, "\uFFFD")
+	if e.format == XMLFormat {
+		if i := strings.IndexFunc(s, isInvalidXMLChar); i >= 0 {
+			return nil, &UnsupportedValueError{v, "string with character " + quoteRune(s, i) + " in XML"}
+		}
+	}
+	return s, nil
+}
+
+func (e *encoder) array(v reflect.Value) (array, error) {
+	values := make(array, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		x, err := e.encode(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		if x == nil {
+			return nil, &UnsupportedValueError{v.Index(i), "nil value in " + v.Type().String()}
+		}
+		values = append(values, x)
+	}
+	return values, nil
+}
+
+// dictionary returns the dictionary object for the map v with keys in sorted
+// order.
+func (e *encoder) dictionary(v reflect.Value) (value, error) {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	d := &dict{}
+	for _, k := range keys {
+		if err := e.set(d, k, k.String(), v.MapIndex(k)); err != nil {
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// fields sets dictionary values for fields of the struct v.
+func (e *encoder) fields(d *dict, v reflect.Value) error {
+	for _, f := range cachedFields(v.Type()) {
+		fv := v.FieldByIndex(f.index)
+		if f.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		if err := e.set(d, fv, f.name, fv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// set appends the key and the encoded value to the dictionary, unless the
+// value is nil.
+func (e *encoder) set(d *dict, kv reflect.Value, key string, v reflect.Value) error {
+	x, err := e.encode(v)
+	if err != nil || x == nil {
+		return err
+	}
+	k, err := e.string(kv, key)
+	if err != nil {
+		return err
+	}
+	d.keys = append(d.keys, k.(string))
+	d.values = append(d.values, x)
+	return nil
+}
@@ -0,0 +1,124 @@
+package plist
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// field is a struct field that maps to a dictionary key.
+type field struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// fieldCache maps struct types to their fields.
+var fieldCache sync.Map // map[reflect.Type][]field
+
+// cachedFields returns the fields of the struct type t.
+func cachedFields(t reflect.Type) []field {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]field)
+	}
+	f, _ := fieldCache.LoadOrStore(t, typeFields(t, nil))
+	return f.([]field)
+}
+
+// typeFields returns the fields of the struct type t whose index in the
+// parent struct is index. Fields of anonymous structs are listed first and are
+// hidden by fields of t with the same name.
+func typeFields(t reflect.Type, index []int) []field {
+	var embedded, own []field
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("plist")
+		if tag == "-" {
+			continue
+		}
+		idx := make([]int, len(index)+1)
+		copy(idx, index)
+		idx[len(index)] = i
+
+		name, omitEmpty := parseTag(tag)
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct && f.Type != timeType {
+			// Exported fields of embedded structs are promoted even if
+			// the struct type is unexported.
+			embedded = appendFields(embedded, typeFields(f.Type, idx))
+			continue
+		}
+		if f.PkgPath != "" {
+			// Unexported field.
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		own = append(own, field{name, idx, omitEmpty})
+	}
+	return appendFields(embedded, own)
+}
+
+// appendFields appends fields to list, replacing fields with the same name.
+func appendFields(list, fields []field) []field {
+	for _, f := range fields {
+		replaced := false
+		for i := range list {
+			if list[i].name == f.name {
+				list[i], replaced = f, true
+				break
+			}
+		}
+		if !replaced {
+			list = append(list, f)
+		}
+	}
+	return list
+}
+
+// lookupField returns the field for the dictionary key, preferring an exact
+// match over a case-insensitive one.
+func lookupField(fields []field, key string) (*field, bool) {
+	for i := range fields {
+		if fields[i].name == key {
+			return &fields[i], true
+		}
+	}
+	for i := range fields {
+		if strings.EqualFold(fields[i].name, key) {
+			return &fields[i], true
+		}
+	}
+	return nil, false
+}
+
+// parseTag splits a struct field’s plist tag into its name and reports
+// whether it has omitempty option.
+func parseTag(tag string) (name string, omitEmpty bool) {
+	opts := strings.Split(tag, ",")
+	for _, opt := range opts[1:] {
+		if opt == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return opts[0], omitEmpty
+}
+
+// isEmptyValue reports whether v is empty as defined by encoding/json.
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+//go:build go1.18
+// +build go1.18
+
+package plist_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/plist"
+)
+
+func FuzzUnmarshal(f *testing.F) {
+	b, _ := hex.DecodeString(binaryFixture)
+	f.Add(b)
+	f.Add([]byte(xmlFixture))
+	for _, v := range []interface{}{
+		map[string]interface{}{"a": []interface{}{int64(-1), 1.5, true}},
+		plist.Set{plist.UID(1), []byte{0}, "é"},
+		uint64(math.MaxUint64),
+	} {
+		for _, format := range formats {
+			b, err := plist.Marshal(v, format)
+			if err != nil {
+				f.Fatal(err)
+			}
+			f.Add(b)
+		}
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v interface{}
+		if _, err := plist.Unmarshal(data, &v); err != nil {
+			if !errors.As(err, new(*plist.FormatError)) {
+				t.Fatalf("unexpected error type %T: %v", err, err)
+			}
+			return
+		}
+		for _, format := range formats {
+			b, err := plist.Marshal(v, format)
+			if err != nil {
+				// Property lists with null objects and XML
+				// with unsupported characters or dates.
+				if !errors.As(err, new(*plist.UnsupportedValueError)) {
+					t.Fatalf("unexpected error type %T: %v", err, err)
+				}
+				continue
+			}
+			var got interface{}
+			if _, err := plist.Unmarshal(b, &got); err != nil {
+				t.Fatalf("failed to unmarshal %v property list: %v", format, err)
+			}
+			if !equal(v, got, format) {
+				t.Fatalf("%v property list did not round-trip: %#v != %#v", format, v, got)
+			}
+		}
+	})
+}
+
+// equal reports whether the value a is equal to b after a round-trip through
+// a property list in the given format.
+func equal(a, b interface{}, format plist.Format) bool {
+	switch a := a.(type) {
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !equal(a[i], b[i], format) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		if uid, ok := b.(plist.UID); ok && format == plist.XMLFormat {
+			// A dictionary with a single CF$UID key is a UID in XML.
+			return len(a) == 1 && equal(a["CF$UID"], int64(uid), format)
+		}
+		b, ok := b.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		n := 0
+		for k, x := range a {
+			if x == nil {
+				// Nil values are omitted.
+				continue
+			}
+			n++
+			if !equal(x, b[k], format) {
+				return false
+			}
+		}
+		return n == len(b)
+	case []byte:
+		b, ok := b.([]byte)
+		return ok && string(a) == string(b)
+	case float64:
+		b, ok := b.(float64)
+		return ok && (a == b || math.IsNaN(a) && math.IsNaN(b))
+	case *big.Int:
+		b, ok := b.(*big.Int)
+		return ok && a.Cmp(b) == 0
+	case time.Time:
+		b, ok := b.(time.Time)
+		if !ok {
+			return false
+		}
+		if format == plist.XMLFormat {
+			return a.Truncate(time.Second).Equal(b)
+		}
+		d := a.Sub(b)
+		return d > -time.Microsecond && d < time.Microsecond
+	}
+	return a == b
+}
+
+func FuzzUnarchive(f *testing.F) {
+	for _, name := range []string{"foundation.plist", "bookmark.plist"} {
+		b, err := os.ReadFile("testdata/" + name)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+		v, err := plist.Unarchive(b)
+		if err != nil {
+			f.Fatal(err)
+		}
+		if b, err = plist.Archive(v); err != nil {
+			f.Fatal(err)
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		v, err := plist.Unarchive(data)
+		if err != nil {
+			if !errors.As(err, new(*plist.FormatError)) && !errors.As(err, new(*plist.ArchiveError)) {
+				t.Fatalf("unexpected error type %T: %v", err, err)
+			}
+			return
+		}
+		b, err := plist.Archive(v)
+		if err != nil {
+			t.Fatalf("failed to archive unarchived value: %v", err)
+		}
+		if _, err := plist.Unarchive(b); err != nil {
+			t.Fatalf("failed to unarchive archived value: %v", err)
+		}
+	})
+}
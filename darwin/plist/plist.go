@@ -0,0 +1,237 @@
+// Package plist implements encoding and decoding of property lists in binary
+// and XML formats without Core Foundation.
+//
+// Overview
+//
+// Property lists organize data into named values and lists of values using
+// a small set of object types: strings, data, booleans, integers, reals,
+// dates, arrays and dictionaries. The binary format additionally supports
+// sets and UIDs, i.e. object references used by NSKeyedArchiver.
+//
+// The mapping between property list objects and Go values follows the spirit
+// of encoding/json and corefoundation.FromGo, see Marshal and Unmarshal for
+// details. Decoding does not depend on the host operating system, so property
+// lists produced by CFPropertyListCreateData can be read on other platforms.
+//
+// Unarchive and Archive decode and encode keyed archives, i.e. object graphs
+// serialized by NSKeyedArchiver as property lists with UID references between
+// objects.
+//
+// References
+//  • https://developer.apple.com/library/archive/documentation/CoreFoundation/Conceptual/CFPropertyLists/CFPropertyLists.html
+//  • https://opensource.apple.com/source/CF/CF-1153.18/CFBinaryPList.c
+package plist
+
+import (
+	"bytes"
+	"reflect"
+	"strconv"
+)
+
+// Format is a format of serialized property lists. The values are the same as
+// the corresponding corefoundation.PropertyListFormat constants.
+type Format int
+
+const (
+	// XMLFormat is the XML format version 1.0.
+	XMLFormat Format = 100
+	// BinaryFormat is the binary format version 1.0, i.e. “bplist00”.
+	BinaryFormat Format = 200
+)
+
+// String implements the fmt.Stringer interface.
+func (f Format) String() string {
+	switch f {
+	case XMLFormat:
+		return "XML"
+	case BinaryFormat:
+		return "binary"
+	}
+	return "Format(" + strconv.Itoa(int(f)) + ")"
+}
+
+// UID is a unique identifier of an object in a keyed archive. In the XML
+// format, UIDs are represented as dictionaries with a single “CF$UID” key.
+//
+// References
+//  • https://developer.apple.com/documentation/foundation/nskeyedarchiver
+type UID uint64
+
+// Set is an unordered collection of values. Sets are only supported by the
+// binary format and are written as arrays in the XML format. Decoding a set
+// into an interface value produces []interface{}.
+type Set []interface{}
+
+var (
+	uidType = reflect.TypeOf(UID(0))
+	setType = reflect.TypeOf(Set(nil))
+)
+
+// A FormatError describes malformed input.
+type FormatError struct {
+	Format Format
+	Msg    string
+}
+
+// Error implements the error interface.
+func (e *FormatError) Error() string {
+	return "plist: invalid " + e.Format.String() + " property list: " + e.Msg
+}
+
+// UnsupportedTypeError is returned by Marshal when attempting to encode a Go
+// value of unsupported type.
+type UnsupportedTypeError struct {
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *UnsupportedTypeError) Error() string {
+	return "plist: unsupported type: " + e.Type.String()
+}
+
+// UnsupportedValueError is returned by Marshal when attempting to encode an
+// unsupported value, e.g. a cyclic data structure, a nil value outside of
+// a struct or map or a string or a date that cannot be represented in XML.
+type UnsupportedValueError struct {
+	Value reflect.Value
+	Str   string
+}
+
+// Error implements the error interface.
+func (e *UnsupportedValueError) Error() string {
+	return "plist: unsupported value: " + e.Str
+}
+
+// An UnmarshalTypeError describes a property list object that was not
+// appropriate for a value of a specific Go type.
+type UnmarshalTypeError struct {
+	Value string       // description of the object, e.g. "string"
+	Type  reflect.Type // type of Go value it could not be assigned to
+	Field string       // path to the struct field, if any
+}
+
+// Error implements the error interface.
+func (e *UnmarshalTypeError) Error() string {
+	if e.Field != "" {
+		return "plist: cannot unmarshal " + e.Value + " into Go struct field " + e.Field + " of type " + e.Type.String()
+	}
+	return "plist: cannot unmarshal " + e.Value + " into Go value of type " + e.Type.String()
+}
+
+// An InvalidUnmarshalError describes an invalid argument passed to Unmarshal,
+// i.e. a value that is not a non-nil pointer.
+type InvalidUnmarshalError struct {
+	Type reflect.Type
+}
+
+// Error implements the error interface.
+func (e *InvalidUnmarshalError) Error() string {
+	if e.Type == nil {
+		return "plist: Unmarshal(nil)"
+	}
+	if e.Type.Kind() != reflect.Ptr {
+		return "plist: Unmarshal(non-pointer " + e.Type.String() + ")"
+	}
+	return "plist: Unmarshal(nil " + e.Type.String() + ")"
+}
+
+// Marshal returns the property list encoding of v in the given format.
+//
+// Marshal converts values as follows:
+//  • strings to strings, replacing invalid UTF-8 with U+FFFD;
+//  • byte slices and arrays to data;
+//  • booleans to booleans;
+//  • signed and unsigned integers and *big.Int values that fit in 128 bits
+//    to integers;
+//  • floating point numbers to reals of the same size;
+//  • time.Time to dates;
+//  • UID to UIDs;
+//  • Set to sets;
+//  • other slices and arrays to arrays;
+//  • maps with string keys to dictionaries;
+//  • structs to dictionaries.
+//
+// Pointers and interfaces are encoded as the value they point to. Nil
+// pointers, interfaces, slices and maps are omitted from dictionaries and
+// are an error elsewhere, since property lists have no null object.
+//
+// Each exported struct field becomes a dictionary key, unless the field’s tag
+// is "-". The “plist” key in the struct field’s tag value is the key name,
+// followed by an optional comma and options. The “omitempty” option omits the
+// field if it has an empty value as defined by encoding/json. Exported
+// anonymous struct fields without a name in the tag are flattened into the
+// parent dictionary, with fields of the parent taking precedence.
+//
+// Marshal returns UnsupportedTypeError for channels, functions, complex
+// numbers and maps with non-string keys, and UnsupportedValueError for
+// cyclic data structures and other values that cannot be encoded.
+func Marshal(v interface{}, format Format) ([]byte, error) {
+	e := encoder{format: format, visiting: make(map[visitKey]bool)}
+	root, err := e.encode(reflect.ValueOf(v))
+	if err != nil {
+		return nil, err
+	}
+	if root == nil {
+		return nil, &UnsupportedValueError{reflect.ValueOf(v), "nil value"}
+	}
+	var buf bytes.Buffer
+	switch format {
+	case XMLFormat:
+		writeXML(&buf, root)
+	case BinaryFormat:
+		writeBinary(&buf, root)
+	default:
+		return nil, &UnsupportedValueError{reflect.ValueOf(format), "unknown format " + format.String()}
+	}
+	return buf.Bytes(), nil
+}
+
+// Unmarshal parses a property list in binary or XML format and stores the
+// result in the value pointed to by v. It returns the format of the data.
+//
+// Unmarshal allocates maps, slices and pointers as necessary and uses the
+// inverse of the mapping used by Marshal, matching dictionary keys to struct
+// fields by name, preferring an exact match but also accepting
+// a case-insensitive match. Integers and reals may be stored in any numeric
+// Go type that holds the value without overflow, and sets may be stored in
+// slices. Dictionary keys that do not match any struct field are ignored.
+//
+// To unmarshal a property list into an interface value, Unmarshal stores:
+//  • string for strings;
+//  • []byte for data;
+//  • bool for booleans;
+//  • int64 for integers that fit, otherwise uint64 or *big.Int;
+//  • float64 for reals;
+//  • time.Time for dates;
+//  • UID for UIDs;
+//  • []interface{} for arrays and sets;
+//  • map[string]interface{} for dictionaries;
+//  • nil for null objects in binary property lists.
+//
+// If an object is not appropriate for a given target type, Unmarshal skips
+// that object and completes the unmarshaling as best it can, returning the
+// first UnmarshalTypeError encountered.
+func Unmarshal(data []byte, v interface{}) (Format, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return 0, &InvalidUnmarshalError{reflect.TypeOf(v)}
+	}
+	root, format, err := read(data)
+	if err != nil {
+		return 0, err
+	}
+	var d decoder
+	d.decode(root, rv.Elem())
+	return format, d.err
+}
+
+// read reads a property list in binary or XML format and returns the root
+// object and the format.
+func read(data []byte) (value, Format, error) {
+	if bytes.HasPrefix(data, []byte(binaryMagic)) {
+		v, err := readBinary(data)
+		return v, BinaryFormat, err
+	}
+	v, err := readXML(data)
+	return v, XMLFormat, err
+}
@@ -0,0 +1,436 @@
+package plist_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/noncgo/x/darwin/plist"
+)
+
+type testBase struct {
+	ID   int64
+	Name string `plist:"base"`
+}
+
+type testValue struct {
+	testBase
+	Name     string            `plist:"name"`
+	Tags     []string          `plist:"tags"`
+	Created  time.Time         `plist:"created"`
+	Raw      []byte            `plist:"raw"`
+	Ratio    float32           `plist:"ratio"`
+	Count    uint8             `plist:"count"`
+	Big      uint64            `plist:"big"`
+	Huge     *big.Int          `plist:"huge"`
+	Enabled  bool              `plist:"enabled"`
+	Object   plist.UID         `plist:"object"`
+	Members  plist.Set         `plist:"members"`
+	Missing  *int              `plist:"missing"`
+	Optional string            `plist:"optional,omitempty"`
+	Ignored  string            `plist:"-"`
+	Nested   map[string]string `plist:"nested"`
+	hidden   string
+}
+
+var formats = []plist.Format{plist.XMLFormat, plist.BinaryFormat}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-85070591730234615865843651857942052864", 10) // -2¹²⁶
+	v := testValue{
+		testBase: testBase{ID: -42, Name: "base"},
+		Name:     "example ✓",
+		Tags:     []string{"a", "b", "a"},
+		Created:  time.Date(2022, 6, 1, 12, 34, 56, 0, time.UTC),
+		Raw:      []byte{1, 2, 3},
+		Ratio:    0.5,
+		Count:    255,
+		Big:      math.MaxUint64,
+		Huge:     huge,
+		Enabled:  true,
+		Object:   7,
+		Members:  plist.Set{"x", int64(1)},
+		Ignored:  "ignored",
+		Nested:   map[string]string{"k": "<&>\r\n"},
+		hidden:   "hidden",
+	}
+	for _, format := range formats {
+		t.Run(format.String(), func(t *testing.T) {
+			b, err := plist.Marshal(&v, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got testValue
+			f, err := plist.Unmarshal(b, &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f != format {
+				t.Errorf("expected %v format, got %v", format, f)
+			}
+			want := v
+			want.Ignored, want.hidden = "", ""
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %+v, got %+v", want, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalInterface(t *testing.T) {
+	v := map[string]interface{}{
+		"string": "s",
+		"data":   []byte{0},
+		"bool":   false,
+		"int":    int64(-1),
+		"uint":   uint64(math.MaxUint64),
+		"real":   1.25,
+		"date":   time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC),
+		"uid":    plist.UID(1),
+		"array":  []interface{}{"a", int64(1)},
+		"dict":   map[string]interface{}{},
+	}
+	for _, format := range formats {
+		t.Run(format.String(), func(t *testing.T) {
+			b, err := plist.Marshal(v, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got interface{}
+			if _, err := plist.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, v) {
+				t.Errorf("expected %#v, got %#v", v, got)
+			}
+		})
+	}
+}
+
+// binaryFixture is a hand-written binary property list with an array of
+// a UTF-16 string, a set, a UID, a null object, a single precision real,
+// a 128-bit integer and a date.
+const binaryFixture = "62706c6973743030" + // bplist00
+	"a701020304050607" + // array of objects 1-7
+	"6100e9" + // "é"
+	"c108" + // set of object 8
+	"8005" + // UID 5
+	"00" + // null
+	"223fc00000" + // 1.5
+	"140000000000000001" + "0000000000000000" + // 2⁶⁴
+	"330000000000000000" + // 2001-01-01
+	"5178" + // "x"
+	"0810131517181d2e37" + // offset table
+	"000000000000" + "01" + "01" + // offset and reference size
+	"0000000000000009" + "0000000000000000" + "0000000000000039"
+
+func TestUnmarshalBinaryFixture(t *testing.T) {
+	b, err := hex.DecodeString(binaryFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got interface{}
+	format, err := plist.Unmarshal(b, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != plist.BinaryFormat {
+		t.Errorf("expected binary format, got %v", format)
+	}
+	want := []interface{}{
+		"é",
+		[]interface{}{"x"},
+		plist.UID(5),
+		nil,
+		1.5,
+		new(big.Int).Lsh(big.NewInt(1), 64),
+		time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %#v, got %#v", want, got)
+	}
+}
+
+const xmlFixture = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<!-- comment -->
+<dict>
+	<key>date</key>
+	<date>2022-06-01T12:34:56Z</date>
+	<key>data</key>
+	<data>
+	AQID
+	BA==
+	</data>
+	<key>empty</key>
+	<array/>
+	<key>real</key>
+	<real>-infinity</real>
+	<key>ref</key>
+	<dict>
+		<key>CF$UID</key>
+		<integer>3</integer>
+	</dict>
+	<key>string</key>
+	<string>a &amp; b</string>
+	<key>true</key>
+	<true/>
+</dict>
+</plist>
+`
+
+func TestUnmarshalXMLFixture(t *testing.T) {
+	var got interface{}
+	format, err := plist.Unmarshal([]byte(xmlFixture), &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != plist.XMLFormat {
+		t.Errorf("expected XML format, got %v", format)
+	}
+	want := map[string]interface{}{
+		"date":   time.Date(2022, 6, 1, 12, 34, 56, 0, time.UTC),
+		"data":   []byte{1, 2, 3, 4},
+		"empty":  []interface{}{},
+		"real":   math.Inf(-1),
+		"ref":    plist.UID(3),
+		"string": "a & b",
+		"true":   true,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %#v, got %#v", want, got)
+	}
+}
+
+func TestMarshalXML(t *testing.T) {
+	b, err := plist.Marshal(map[string]interface{}{
+		"a": []interface{}{int64(1), "x"},
+		"b": map[string]bool{},
+	}, plist.XMLFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>a</key>
+	<array>
+		<integer>1</integer>
+		<string>x</string>
+	</array>
+	<key>b</key>
+	<dict/>
+</dict>
+</plist>
+`
+	if string(b) != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, b)
+	}
+}
+
+// trailer returns the offset table integer size, the object reference size
+// and the number of objects of a binary property list.
+func trailer(b []byte) (offsetSize, refSize int, numObjects int) {
+	t := b[len(b)-32:]
+	n := 0
+	for _, c := range t[8:16] {
+		n = n<<8 | int(c)
+	}
+	return int(t[6]), int(t[7]), n
+}
+
+func TestMarshalBinaryDedup(t *testing.T) {
+	b, err := plist.Marshal([]interface{}{
+		"a", "a", int64(1), int64(1), 1.0, []byte("a"), []string{"a"},
+	}, plist.BinaryFormat)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The root array, "a", 1, 1.0, data and the nested array.
+	if _, _, n := trailer(b); n != 6 {
+		t.Errorf("expected 6 objects, got %d", n)
+	}
+}
+
+func TestMarshalBinarySizes(t *testing.T) {
+	testCases := []struct {
+		name       string
+		v          interface{}
+		offsetSize int
+		refSize    int
+	}{
+		{"Small", []int{1, 2, 3}, 1, 1},
+		{"LongOffsets", [][]byte{make([]byte, 300)}, 2, 1},
+		{"ManyObjects", func() []int {
+			s := make([]int, 300)
+			for i := range s {
+				s[i] = i
+			}
+			return s
+		}(), 2, 2},
+		{"HugeOffsets", [][]byte{make([]byte, 1<<16)}, 4, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := plist.Marshal(tc.v, plist.BinaryFormat)
+			if err != nil {
+				t.Fatal(err)
+			}
+			offsetSize, refSize, _ := trailer(b)
+			if offsetSize != tc.offsetSize || refSize != tc.refSize {
+				t.Errorf("expected offset size %d and reference size %d, got %d and %d",
+					tc.offsetSize, tc.refSize, offsetSize, refSize)
+			}
+			got := reflect.New(reflect.TypeOf(tc.v))
+			if _, err := plist.Unmarshal(b, got.Interface()); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Elem().Interface(), tc.v) {
+				t.Error("value did not round-trip")
+			}
+		})
+	}
+}
+
+func TestMarshalIntegers(t *testing.T) {
+	testCases := []struct {
+		v      interface{}
+		marker byte
+	}{
+		{uint8(1), 0x10},
+		{uint16(0x100), 0x11},
+		{int32(math.MaxInt32), 0x12},
+		{int64(math.MaxUint32 + 1), 0x13},
+		{int8(-1), 0x13},
+		{uint64(math.MaxUint64), 0x14},
+	}
+	for _, tc := range testCases {
+		b, err := plist.Marshal(tc.v, plist.BinaryFormat)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b[8] != tc.marker {
+			t.Errorf("%T(%v): expected marker %#x, got %#x", tc.v, tc.v, tc.marker, b[8])
+		}
+	}
+}
+
+func TestMarshalErrors(t *testing.T) {
+	type cyclic struct {
+		Next *cyclic
+	}
+	c := &cyclic{}
+	c.Next = c
+
+	tooBig := new(big.Int).Lsh(big.NewInt(1), 127)
+
+	testCases := []struct {
+		name   string
+		v      interface{}
+		format plist.Format
+		err    interface{}
+	}{
+		{"Chan", make(chan int), plist.BinaryFormat, new(*plist.UnsupportedTypeError)},
+		{"Complex", complex(1, 2), plist.BinaryFormat, new(*plist.UnsupportedTypeError)},
+		{"MapKey", map[int]string{1: "a"}, plist.BinaryFormat, new(*plist.UnsupportedTypeError)},
+		{"Nil", nil, plist.BinaryFormat, new(*plist.UnsupportedValueError)},
+		{"NilElement", []interface{}{nil}, plist.BinaryFormat, new(*plist.UnsupportedValueError)},
+		{"Cyclic", c, plist.BinaryFormat, new(*plist.UnsupportedValueError)},
+		{"BigInt", tooBig, plist.BinaryFormat, new(*plist.UnsupportedValueError)},
+		{"ControlChar", "\x00", plist.XMLFormat, new(*plist.UnsupportedValueError)},
+		{"Year", time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC), plist.XMLFormat, new(*plist.UnsupportedValueError)},
+		{"Format", "x", plist.Format(1), new(*plist.UnsupportedValueError)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := plist.Marshal(tc.v, tc.format)
+			if !errors.As(err, tc.err) {
+				t.Errorf("unexpected error %T: %v", err, err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var s string
+	if _, err := plist.Unmarshal([]byte(xmlFixture), s); !errors.As(err, new(*plist.InvalidUnmarshalError)) {
+		t.Errorf("expected InvalidUnmarshalError for non-pointer, got %v", err)
+	}
+
+	var v struct {
+		Date   string
+		String string
+	}
+	_, err := plist.Unmarshal([]byte(xmlFixture), &v)
+	var typeErr *plist.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("expected UnmarshalTypeError, got %v", err)
+	}
+	if typeErr.Field != "Date" || typeErr.Value != "date" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if v.String != "a & b" {
+		t.Errorf("expected the other fields to be set, got %q", v.String)
+	}
+
+	var i int8
+	if _, err := plist.Unmarshal([]byte("<integer>128</integer>"), &i); !errors.As(err, &typeErr) {
+		t.Errorf("expected UnmarshalTypeError for overflow, got %v", err)
+	}
+
+	malformed := []string{
+		"",
+		"<plist><dict><key>x</key></dict></plist>",
+		"<plist><array><foo/></array></plist>",
+		"<integer>1</integer><integer>2</integer>",
+		"<integer>0x10</integer>",
+		"<date>yesterday</date>",
+		strings.Repeat("<array>", 1000) + strings.Repeat("</array>", 1000),
+		"bplist00",
+		binaryFixture[:len(binaryFixture)-2],
+	}
+	for _, data := range malformed {
+		var v interface{}
+		if _, err := plist.Unmarshal([]byte(data), &v); !errors.As(err, new(*plist.FormatError)) {
+			t.Errorf("expected FormatError for %.40q, got %v", data, err)
+		}
+	}
+}
+
+func TestUnmarshalBinaryLimits(t *testing.T) {
+	// Object 0 is an array that contains itself.
+	cyclic, _ := hex.DecodeString("62706c6973743030" + "a100" + "08" +
+		"000000000000" + "01" + "01" + "0000000000000001" + "0000000000000000" + "000000000000000a")
+	// Object i is an array that contains object i+1 twice, so the root
+	// expands to 2³⁰ objects.
+	const depth = 30
+	amplified := []byte("bplist00")
+	for i := 0; i < depth; i++ {
+		amplified = append(amplified, 0xa2, byte(i+1), byte(i+1))
+	}
+	amplified = append(amplified, 0x09)
+	table := len(amplified)
+	for i := 0; i <= depth; i++ {
+		amplified = append(amplified, byte(8+3*i))
+	}
+	amplified = append(amplified, 0, 0, 0, 0, 0, 0, 1, 1)
+	amplified = append(amplified, 0, 0, 0, 0, 0, 0, 0, depth+1)
+	amplified = append(amplified, 0, 0, 0, 0, 0, 0, 0, 0)
+	amplified = append(amplified, 0, 0, 0, 0, 0, 0, 0, byte(table))
+
+	for name, data := range map[string][]byte{"Cyclic": cyclic, "Amplified": amplified} {
+		t.Run(name, func(t *testing.T) {
+			var v interface{}
+			if _, err := plist.Unmarshal(data, &v); !errors.As(err, new(*plist.FormatError)) {
+				t.Errorf("expected FormatError, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,445 @@
+package plist
+
+// This file implements decoding of keyed archives.
+//
+// References
+//  • https://developer.apple.com/documentation/foundation/nskeyedunarchiver
+
+import (
+	"encoding/hex"
+	"net/url"
+	"strconv"
+)
+
+// archiverName is the value of the “$archiver” key of keyed archives.
+const archiverName = "NSKeyedArchiver"
+
+// archiveVersion is the value of the “$version” key of keyed archives.
+const archiveVersion = 100000
+
+// rootKey is the key of the root object in the “$top” dictionary.
+const rootKey = "root"
+
+// nullString is the first object of keyed archives. References to it are
+// nil objects.
+const nullString = "$null"
+
+// UUID is a universally unique identifier, i.e. an NSUUID object in keyed
+// archives.
+type UUID [16]byte
+
+// String returns the canonical text representation of the UUID, e.g.
+// "00112233-4455-6677-8899-AABBCCDDEEFF", as returned by NSUUID’s UUIDString.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	for i, c := range buf {
+		if c >= 'a' && c <= 'f' {
+			buf[i] = c - 'a' + 'A'
+		}
+	}
+	return string(buf[:])
+}
+
+// ArchivedObject is an instance of a class in a keyed archive. Unarchive
+// returns ArchivedObject values for objects of classes without a registered
+// decoder, and Archive encodes them as instances of their class.
+type ArchivedObject struct {
+	// Classes is the class hierarchy, starting with the class of the
+	// object, e.g. ["NSMutableArray", "NSArray", "NSObject"].
+	Classes []string
+	// Fields are the decoded values of the object’s keys. References to
+	// other objects are decoded as described in Unarchive.
+	Fields map[string]interface{}
+}
+
+// Class returns the class name of the object.
+func (o *ArchivedObject) Class() string {
+	if len(o.Classes) == 0 {
+		return ""
+	}
+	return o.Classes[0]
+}
+
+// DecodeFunc returns the Go value for an object of a class in a keyed archive.
+type DecodeFunc func(obj *ArchivedObject) (interface{}, error)
+
+// An ArchiveError describes a malformed keyed archive.
+type ArchiveError struct {
+	Msg string
+}
+
+// Error implements the error interface.
+func (e *ArchiveError) Error() string {
+	return "plist: invalid keyed archive: " + e.Msg
+}
+
+// Unarchiver decodes keyed archives using decoders registered for class
+// names. The zero value has no decoders; use NewUnarchiver for an Unarchiver
+// that decodes the common Foundation classes.
+type Unarchiver struct {
+	decoders map[string]DecodeFunc
+}
+
+// NewUnarchiver returns an Unarchiver with decoders for the classes listed in
+// Unarchive.
+func NewUnarchiver() *Unarchiver {
+	u := &Unarchiver{}
+	u.Register("NSArray", decodeArray)
+	u.Register("NSData", decodeData)
+	u.Register("NSDate", decodeDate)
+	u.Register("NSDictionary", decodeDictionary)
+	u.Register("NSNull", decodeNull)
+	u.Register("NSSet", decodeSet)
+	u.Register("NSString", decodeString)
+	u.Register("NSURL", decodeURL)
+	u.Register("NSUUID", decodeUUID)
+	return u
+}
+
+// Register sets the decoder for objects of the class and its subclasses that
+// do not have a decoder of their own. It replaces any existing decoder for the
+// class.
+func (u *Unarchiver) Register(class string, fn DecodeFunc) {
+	if u.decoders == nil {
+		u.decoders = make(map[string]DecodeFunc)
+	}
+	u.decoders[class] = fn
+}
+
+// Unarchive decodes the keyed archive in binary or XML property list format
+// and returns the root object.
+//
+// Objects stored directly in the archive are decoded as described in
+// Unmarshal. Instances of classes are passed to the decoder registered for
+// the first class in their hierarchy that has one, after decoding their
+// fields; references to other objects in fields, including references in
+// arrays, are decoded recursively. Instances of classes without a decoder are
+// decoded as *ArchivedObject, and are the only objects that may be part of
+// a reference cycle. References to the “$null” object are nil.
+//
+// Decoders returned by NewUnarchiver produce:
+//  • string for NSString and NSMutableString;
+//  • []byte for NSData and NSMutableData;
+//  • time.Time for NSDate;
+//  • []interface{} for NSArray;
+//  • Set for NSSet;
+//  • map[string]interface{} for NSDictionary with string keys;
+//  • *url.URL for NSURL, resolved against its base URL;
+//  • UUID for NSUUID;
+//  • nil for NSNull.
+func (u *Unarchiver) Unarchive(data []byte) (interface{}, error) {
+	root, _, err := read(data)
+	if err != nil {
+		return nil, err
+	}
+	top, ok := root.(*dict)
+	if !ok {
+		return nil, &ArchiveError{"root object is " + kindName(root) + ", not a dictionary"}
+	}
+	objects, ok := top.get("$objects").(array)
+	if !ok {
+		return nil, &ArchiveError{"missing $objects array"}
+	}
+	refs, ok := top.get("$top").(*dict)
+	if !ok {
+		return nil, &ArchiveError{"missing $top dictionary"}
+	}
+	ref, ok := refs.get(rootKey).(UID)
+	if !ok {
+		return nil, &ArchiveError{"missing root object reference"}
+	}
+	d := unarchiver{
+		decoders: u.decoders,
+		objects:  objects,
+		decoded:  make([]interface{}, len(objects)),
+		state:    make([]objectState, len(objects)),
+	}
+	return d.object(ref)
+}
+
+// Unarchive decodes the keyed archive using the decoders returned by
+// NewUnarchiver. See Unarchiver.Unarchive for details.
+func Unarchive(data []byte) (interface{}, error) {
+	return NewUnarchiver().Unarchive(data)
+}
+
+// objectState is the decoding state of an object in the archive.
+type objectState uint8
+
+const (
+	objectPending objectState = iota
+	// objectDecoding is the state of objects whose fields are being decoded.
+	objectDecoding
+	// objectProvisional is the state of instances of classes without
+	// a decoder whose fields are being decoded. References to such objects
+	// use the incomplete ArchivedObject.
+	objectProvisional
+	objectDecoded
+)
+
+// unarchiver resolves references in a keyed archive.
+type unarchiver struct {
+	decoders map[string]DecodeFunc
+	objects  array
+	// decoded are the decoded objects by UID.
+	decoded []interface{}
+	state   []objectState
+	depth   int
+}
+
+// object returns the decoded object with the UID.
+func (u *unarchiver) object(uid UID) (interface{}, error) {
+	if uid >= UID(len(u.objects)) {
+		return nil, &ArchiveError{"reference " + strconv.FormatUint(uint64(uid), 10) + " out of range"}
+	}
+	switch u.state[uid] {
+	case objectDecoding:
+		return nil, &ArchiveError{"cyclic reference to object " + strconv.FormatUint(uint64(uid), 10)}
+	case objectProvisional, objectDecoded:
+		return u.decoded[uid], nil
+	}
+	switch v := u.objects[uid].(type) {
+	case string:
+		if uid == 0 && v == nullString {
+			return nil, nil
+		}
+	case *dict:
+		if u.depth >= maxDepth {
+			return nil, &ArchiveError{"exceeded maximum nesting depth"}
+		}
+		u.depth++
+		defer func() { u.depth-- }()
+		x, err := u.instance(uid, v)
+		if err != nil {
+			return nil, err
+		}
+		u.decoded[uid] = x
+		u.state[uid] = objectDecoded
+		return x, nil
+	case UID, array, set:
+		return nil, &ArchiveError{"unexpected " + kindName(v) + " object"}
+	}
+	x := toInterface(u.objects[uid])
+	u.decoded[uid] = x
+	u.state[uid] = objectDecoded
+	return x, nil
+}
+
+// instance decodes the instance of a class with the UID.
+func (u *unarchiver) instance(uid UID, d *dict) (interface{}, error) {
+	ref, ok := d.get("$class").(UID)
+	if !ok {
+		return nil, &ArchiveError{"object " + strconv.FormatUint(uint64(uid), 10) + " without $class"}
+	}
+	classes, err := u.class(ref)
+	if err != nil {
+		return nil, err
+	}
+	var fn DecodeFunc
+	for _, c := range classes {
+		if fn = u.decoders[c]; fn != nil {
+			break
+		}
+	}
+	obj := &ArchivedObject{Classes: classes, Fields: make(map[string]interface{}, len(d.keys))}
+	if fn == nil {
+		u.decoded[uid] = obj
+		u.state[uid] = objectProvisional
+	} else {
+		u.state[uid] = objectDecoding
+	}
+	for i, k := range d.keys {
+		if k == "$class" {
+			continue
+		}
+		x, err := u.field(d.values[i])
+		if err != nil {
+			return nil, err
+		}
+		obj.Fields[k] = x
+	}
+	if fn == nil {
+		return obj, nil
+	}
+	return fn(obj)
+}
+
+// class returns the class hierarchy of the class description with the UID.
+func (u *unarchiver) class(uid UID) ([]string, error) {
+	if uid >= UID(len(u.objects)) {
+		return nil, &ArchiveError{"class reference " + strconv.FormatUint(uint64(uid), 10) + " out of range"}
+	}
+	d, ok := u.objects[uid].(*dict)
+	if !ok {
+		return nil, &ArchiveError{"class " + strconv.FormatUint(uint64(uid), 10) + " is " + kindName(u.objects[uid]) + ", not a dictionary"}
+	}
+	name, ok := d.get("$classname").(string)
+	if !ok {
+		return nil, &ArchiveError{"class " + strconv.FormatUint(uint64(uid), 10) + " without $classname"}
+	}
+	values, _ := d.get("$classes").(array)
+	classes := make([]string, 0, len(values)+1)
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			classes = append(classes, s)
+		}
+	}
+	if len(classes) == 0 || classes[0] != name {
+		classes = append([]string{name}, classes...)
+	}
+	return classes, nil
+}
+
+// field decodes the value of an instance’s key.
+func (u *unarchiver) field(v value) (interface{}, error) {
+	switch v := v.(type) {
+	case UID:
+		return u.object(v)
+	case array:
+		return u.fields(v)
+	case set:
+		return u.fields(v)
+	case *dict:
+		m := make(map[string]interface{}, len(v.keys))
+		for i, k := range v.keys {
+			x, err := u.field(v.values[i])
+			if err != nil {
+				return nil, err
+			}
+			m[k] = x
+		}
+		return m, nil
+	}
+	return toInterface(v), nil
+}
+
+func (u *unarchiver) fields(values []value) ([]interface{}, error) {
+	s := make([]interface{}, len(values))
+	for i, v := range values {
+		x, err := u.field(v)
+		if err != nil {
+			return nil, err
+		}
+		s[i] = x
+	}
+	return s, nil
+}
+
+// objectsField returns the array of objects for the key of an NSArray, NSSet or
+// NSDictionary.
+func objectsField(obj *ArchivedObject, key string) ([]interface{}, error) {
+	s, ok := obj.Fields[key].([]interface{})
+	if !ok {
+		return nil, &ArchiveError{obj.Class() + " without " + key + " array"}
+	}
+	return s, nil
+}
+
+func decodeArray(obj *ArchivedObject) (interface{}, error) {
+	return objectsField(obj, "NS.objects")
+}
+
+func decodeSet(obj *ArchivedObject) (interface{}, error) {
+	s, err := objectsField(obj, "NS.objects")
+	if err != nil {
+		return nil, err
+	}
+	return Set(s), nil
+}
+
+func decodeDictionary(obj *ArchivedObject) (interface{}, error) {
+	keys, err := objectsField(obj, "NS.keys")
+	if err != nil {
+		return nil, err
+	}
+	values, err := objectsField(obj, "NS.objects")
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) != len(values) {
+		return nil, &ArchiveError{obj.Class() + " with " + strconv.Itoa(len(keys)) + " keys and " + strconv.Itoa(len(values)) + " values"}
+	}
+	m := make(map[string]interface{}, len(keys))
+	for i, k := range keys {
+		s, ok := k.(string)
+		if !ok {
+			return nil, &ArchiveError{obj.Class() + " with non-string key"}
+		}
+		m[s] = values[i]
+	}
+	return m, nil
+}
+
+func decodeString(obj *ArchivedObject) (interface{}, error) {
+	s, ok := obj.Fields["NS.string"].(string)
+	if !ok {
+		return nil, &ArchiveError{obj.Class() + " without NS.string"}
+	}
+	return s, nil
+}
+
+func decodeData(obj *ArchivedObject) (interface{}, error) {
+	b, ok := obj.Fields["NS.data"].([]byte)
+	if !ok {
+		return nil, &ArchiveError{obj.Class() + " without NS.data"}
+	}
+	return b, nil
+}
+
+func decodeDate(obj *ArchivedObject) (interface{}, error) {
+	var at float64
+	switch v := obj.Fields["NS.time"].(type) {
+	case float64:
+		at = v
+	case int64:
+		at = float64(v)
+	default:
+		return nil, &ArchiveError{obj.Class() + " without NS.time"}
+	}
+	t, ok := timeOf(at)
+	if !ok {
+		return nil, &ArchiveError{obj.Class() + " out of range"}
+	}
+	return t, nil
+}
+
+func decodeURL(obj *ArchivedObject) (interface{}, error) {
+	s, ok := obj.Fields["NS.relative"].(string)
+	if !ok {
+		return nil, &ArchiveError{obj.Class() + " without NS.relative"}
+	}
+	rel, err := url.Parse(s)
+	if err != nil {
+		return nil, &ArchiveError{obj.Class() + " with invalid NS.relative: " + err.Error()}
+	}
+	switch base := obj.Fields["NS.base"].(type) {
+	case nil:
+		return rel, nil
+	case *url.URL:
+		return base.ResolveReference(rel), nil
+	}
+	return nil, &ArchiveError{obj.Class() + " with invalid NS.base"}
+}
+
+func decodeUUID(obj *ArchivedObject) (interface{}, error) {
+	b, ok := obj.Fields["NS.uuidbytes"].([]byte)
+	if !ok || len(b) != len(UUID{}) {
+		return nil, &ArchiveError{obj.Class() + " without 16 NS.uuidbytes"}
+	}
+	var u UUID
+	copy(u[:], b)
+	return u, nil
+}
+
+func decodeNull(obj *ArchivedObject) (interface{}, error) {
+	return nil, nil
+}
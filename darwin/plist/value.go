@@ -0,0 +1,206 @@
+package plist
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// value is a property list object. It is one of string, []byte, bool,
+// integer, real, time.Time, UID, array, set, *dict or nil for the null object
+// of binary property lists.
+//
+// Values are produced by the encoder and by the readers, and are consumed by
+// the writers and by the decoder. Binary readers may return values that share
+// subtrees, so values must not be modified.
+type value interface{}
+
+// integer is a signed 128-bit integer in two’s complement representation.
+// Property lists store unsigned 64-bit values that overflow int64 as 128-bit
+// integers.
+type integer struct {
+	hi, lo uint64
+}
+
+// real is a floating point number. Single precision reals are stored in four
+// bytes in the binary format.
+type real struct {
+	v      float64
+	single bool
+}
+
+// array is an ordered collection of values.
+type array []value
+
+// set is an unordered collection of values.
+type set []value
+
+// dict is a collection of key-value pairs in the order they are written.
+type dict struct {
+	keys   []string
+	values []value
+}
+
+// get returns the value for the key or nil if there is no such key.
+func (d *dict) get(key string) value {
+	for i, k := range d.keys {
+		if k == key {
+			return d.values[i]
+		}
+	}
+	return nil
+}
+
+func intOf(v int64) integer {
+	if v < 0 {
+		return integer{math.MaxUint64, uint64(v)}
+	}
+	return integer{0, uint64(v)}
+}
+
+func uintOf(v uint64) integer {
+	return integer{0, v}
+}
+
+var (
+	minInt128 = new(big.Int).Lsh(big.NewInt(-1), 127)
+	maxInt128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+)
+
+// bigOf returns the integer value of b and reports whether it fits in 128
+// bits.
+func bigOf(b *big.Int) (integer, bool) {
+	if b.Cmp(minInt128) < 0 || b.Cmp(maxInt128) > 0 {
+		return integer{}, false
+	}
+	// Two’s complement of negative values is 2¹²⁸ + b.
+	u := new(big.Int).Set(b)
+	if b.Sign() < 0 {
+		u.Add(u, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	lo := new(big.Int).And(u, new(big.Int).SetUint64(math.MaxUint64))
+	hi := u.Rsh(u, 64)
+	return integer{hi.Uint64(), lo.Uint64()}, true
+}
+
+// neg reports whether the integer is negative.
+func (i integer) neg() bool {
+	return int64(i.hi) < 0
+}
+
+// int64 returns the integer as int64 and reports whether it fits.
+func (i integer) int64() (int64, bool) {
+	if i.hi == 0 && i.lo <= math.MaxInt64 || i.hi == math.MaxUint64 && i.lo > math.MaxInt64 {
+		return int64(i.lo), true
+	}
+	return 0, false
+}
+
+// uint64 returns the integer as uint64 and reports whether it fits.
+func (i integer) uint64() (uint64, bool) {
+	return i.lo, i.hi == 0
+}
+
+// big returns the integer as *big.Int.
+func (i integer) big() *big.Int {
+	b := new(big.Int).SetUint64(i.hi)
+	b.Lsh(b, 64)
+	b.Or(b, new(big.Int).SetUint64(i.lo))
+	if i.neg() {
+		b.Sub(b, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	return b
+}
+
+// float64 returns the nearest floating point value of the integer.
+func (i integer) float64() float64 {
+	if v, ok := i.int64(); ok {
+		return float64(v)
+	}
+	if v, ok := i.uint64(); ok {
+		return float64(v)
+	}
+	f, _ := new(big.Float).SetInt(i.big()).Float64()
+	return f
+}
+
+// String returns the decimal representation of the integer.
+func (i integer) String() string {
+	if v, ok := i.int64(); ok {
+		return strconv.FormatInt(v, 10)
+	}
+	if v, ok := i.uint64(); ok {
+		return strconv.FormatUint(v, 10)
+	}
+	return i.big().String()
+}
+
+// parseInteger parses a decimal integer with an optional sign.
+func parseInteger(s string) (integer, bool) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return intOf(v), true
+	}
+	if v, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return uintOf(v), true
+	}
+	if len(s) > 0 && s[0] == '+' {
+		s = s[1:]
+	}
+	b, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return integer{}, false
+	}
+	return bigOf(b)
+}
+
+// absoluteTimeEpoch is the Unix time of the reference date of dates in
+// property lists, i.e. 1 January 2001 00:00:00 UTC.
+const absoluteTimeEpoch = 978307200
+
+// absoluteTimeOf returns the number of seconds between the reference date
+// and t.
+func absoluteTimeOf(t time.Time) float64 {
+	return float64(t.Unix()-absoluteTimeEpoch) + float64(t.Nanosecond())/1e9
+}
+
+// timeOf returns the time for the number of seconds since the reference date
+// and reports whether it is in the range of time.Time.
+func timeOf(at float64) (time.Time, bool) {
+	// Both bounds are exactly representable and leave room for the epoch.
+	if !(at >= -1<<62 && at < 1<<62) {
+		return time.Time{}, false
+	}
+	sec := math.Floor(at)
+	nsec := math.Round((at - sec) * 1e9)
+	return time.Unix(int64(sec)+absoluteTimeEpoch, int64(nsec)).UTC(), true
+}
+
+// kindName returns a description of the value for error messages.
+func kindName(v value) string {
+	switch v.(type) {
+	case string:
+		return "string"
+	case []byte:
+		return "data"
+	case bool:
+		return "boolean"
+	case integer:
+		return "integer"
+	case real:
+		return "real"
+	case time.Time:
+		return "date"
+	case UID:
+		return "UID"
+	case array:
+		return "array"
+	case set:
+		return "set"
+	case *dict:
+		return "dictionary"
+	case nil:
+		return "null"
+	}
+	return "unknown object"
+}
@@ -0,0 +1,459 @@
+package plist
+
+// This file implements the XML property list format.
+//
+// References
+//  • https://www.apple.com/DTDs/PropertyList-1.0.dtd
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+`
+
+// xmlDateFormat is the format of dates. Dates in the XML format have
+// a precision of one second.
+const xmlDateFormat = "2006-01-02T15:04:05Z"
+
+// uidKey is the key of dictionaries that represent UIDs.
+const uidKey = "CF$UID"
+
+// dataLineLength is the number of base64 characters per line in data
+// objects.
+const dataLineLength = 68
+
+// writeXML writes the property list with the root object v.
+func writeXML(buf *bytes.Buffer, v value) {
+	buf.WriteString(xmlHeader)
+	writeXMLValue(buf, v, 0)
+	buf.WriteString("</plist>\n")
+}
+
+// writeXMLValue writes the object v indented with depth tabs.
+func writeXMLValue(buf *bytes.Buffer, v value, depth int) {
+	indent := strings.Repeat("\t", depth)
+	buf.WriteString(indent)
+	switch v := v.(type) {
+	case string:
+		writeXMLElement(buf, "string", v)
+	case []byte:
+		if len(v) == 0 {
+			buf.WriteString("<data></data>\n")
+			break
+		}
+		buf.WriteString("<data>\n")
+		s := base64.StdEncoding.EncodeToString(v)
+		for len(s) > 0 {
+			n := dataLineLength
+			if n > len(s) {
+				n = len(s)
+			}
+			buf.WriteString(indent)
+			buf.WriteString(s[:n])
+			buf.WriteByte('\n')
+			s = s[n:]
+		}
+		buf.WriteString(indent)
+		buf.WriteString("</data>\n")
+	case bool:
+		if v {
+			buf.WriteString("<true/>\n")
+		} else {
+			buf.WriteString("<false/>\n")
+		}
+	case integer:
+		writeXMLElement(buf, "integer", v.String())
+	case real:
+		writeXMLElement(buf, "real", formatReal(v))
+	case time.Time:
+		writeXMLElement(buf, "date", v.UTC().Format(xmlDateFormat))
+	case UID:
+		buf.WriteString("<dict>\n")
+		buf.WriteString(indent + "\t")
+		writeXMLElement(buf, "key", uidKey)
+		buf.WriteString(indent + "\t")
+		writeXMLElement(buf, "integer", strconv.FormatUint(uint64(v), 10))
+		buf.WriteString(indent)
+		buf.WriteString("</dict>\n")
+	case array:
+		writeXMLArray(buf, v, indent, depth)
+	case set:
+		writeXMLArray(buf, v, indent, depth)
+	case *dict:
+		if len(v.keys) == 0 {
+			buf.WriteString("<dict/>\n")
+			break
+		}
+		buf.WriteString("<dict>\n")
+		for i, k := range v.keys {
+			buf.WriteString(indent + "\t")
+			writeXMLElement(buf, "key", k)
+			writeXMLValue(buf, v.values[i], depth+1)
+		}
+		buf.WriteString(indent)
+		buf.WriteString("</dict>\n")
+	}
+}
+
+func writeXMLArray(buf *bytes.Buffer, values []value, indent string, depth int) {
+	if len(values) == 0 {
+		buf.WriteString("<array/>\n")
+		return
+	}
+	buf.WriteString("<array>\n")
+	for _, x := range values {
+		writeXMLValue(buf, x, depth+1)
+	}
+	buf.WriteString(indent)
+	buf.WriteString("</array>\n")
+}
+
+// writeXMLElement writes an element with the text content s.
+func writeXMLElement(buf *bytes.Buffer, name, s string) {
+	buf.WriteString("<" + name + ">")
+	for _, c := range s {
+		switch c {
+		case '&':
+			buf.WriteString("&amp;")
+		case '<':
+			buf.WriteString("&lt;")
+		case '>':
+			buf.WriteString("&gt;")
+		case '\r':
+			// Parsers normalize literal carriage returns.
+			buf.WriteString("&#13;")
+		default:
+			buf.WriteRune(c)
+		}
+	}
+	buf.WriteString("</" + name + ">\n")
+}
+
+// formatReal returns the text representation of a real.
+func formatReal(v real) string {
+	switch {
+	case math.IsNaN(v.v):
+		return "nan"
+	case math.IsInf(v.v, 1):
+		return "+infinity"
+	case math.IsInf(v.v, -1):
+		return "-infinity"
+	}
+	bitSize := 64
+	if v.single {
+		bitSize = 32
+	}
+	return strconv.FormatFloat(v.v, 'g', -1, bitSize)
+}
+
+// parseReal parses the text representation of a real.
+func parseReal(s string) (real, bool) {
+	switch strings.ToLower(s) {
+	case "nan":
+		return real{v: math.NaN()}, true
+	case "inf", "+inf", "infinity", "+infinity":
+		return real{v: math.Inf(1)}, true
+	case "-inf", "-infinity":
+		return real{v: math.Inf(-1)}, true
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return real{}, false
+	}
+	return real{v: f}, true
+}
+
+// isInvalidXMLChar reports whether the character is not allowed in XML
+// documents.
+//
+// References
+//  • https://www.w3.org/TR/xml/#charsets
+func isInvalidXMLChar(c rune) bool {
+	switch {
+	case c == '\t' || c == '\n' || c == '\r':
+		return false
+	case c < 0x20:
+		return true
+	case c >= 0xD800 && c <= 0xDFFF, c == 0xFFFE, c == 0xFFFF:
+		return true
+	}
+	return false
+}
+
+// quoteRune returns the quoted character at the byte index i of s.
+func quoteRune(s string, i int) string {
+	c, _ := utf8.DecodeRuneInString(s[i:])
+	return strconv.QuoteRune(c)
+}
+
+// xmlReader reads XML property lists.
+type xmlReader struct {
+	d     *xml.Decoder
+	depth int
+}
+
+// readXML reads an XML property list and returns the root object.
+func readXML(data []byte) (value, error) {
+	r := xmlReader{d: xml.NewDecoder(bytes.NewReader(data))}
+	start, err := r.start()
+	if err != nil {
+		return nil, err
+	}
+	if start.Name.Local != "plist" {
+		// Accept a property list without the plist element.
+		v, err := r.value(start)
+		if err != nil {
+			return nil, err
+		}
+		return v, r.end()
+	}
+	start, err = r.start()
+	if err != nil {
+		return nil, err
+	}
+	v, err := r.value(start)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.close(); err != nil {
+		return nil, err
+	}
+	return v, r.end()
+}
+
+func errXML(msg string) error {
+	return &FormatError{XMLFormat, msg}
+}
+
+// token returns the next token that is not a comment, a processing
+// instruction, a directive or whitespace. Other character data is an error.
+func (r *xmlReader) token() (xml.Token, error) {
+	for {
+		tok, err := r.d.Token()
+		if err == io.EOF {
+			return nil, errXML("unexpected end of input")
+		}
+		if err != nil {
+			return nil, errXML(err.Error())
+		}
+		switch t := tok.(type) {
+		case xml.StartElement, xml.EndElement:
+			return tok, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) != 0 {
+				return nil, errXML("unexpected character data")
+			}
+		}
+	}
+}
+
+// start returns the next start element.
+func (r *xmlReader) start() (xml.StartElement, error) {
+	tok, err := r.token()
+	if err != nil {
+		return xml.StartElement{}, err
+	}
+	start, ok := tok.(xml.StartElement)
+	if !ok {
+		return xml.StartElement{}, errXML("expected start element")
+	}
+	return start, nil
+}
+
+// close reads the end element of the current element.
+func (r *xmlReader) close() error {
+	tok, err := r.token()
+	if err != nil {
+		return err
+	}
+	if _, ok := tok.(xml.EndElement); !ok {
+		return errXML("expected end element")
+	}
+	return nil
+}
+
+// end checks that there are no more elements in the input.
+func (r *xmlReader) end() error {
+	for {
+		tok, err := r.d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errXML(err.Error())
+		}
+		switch t := tok.(type) {
+		case xml.StartElement, xml.EndElement:
+			return errXML("unexpected element after the root object")
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) != 0 {
+				return errXML("unexpected character data")
+			}
+		}
+	}
+}
+
+// text returns the text content of the current element and reads its end
+// element.
+func (r *xmlReader) text() (string, error) {
+	var sb strings.Builder
+	for {
+		tok, err := r.d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return "", errXML("unexpected end of input")
+			}
+			return "", errXML(err.Error())
+		}
+		switch t := tok.(type) {
+		case xml.CharData:
+			sb.Write(t)
+		case xml.StartElement:
+			return "", errXML("unexpected element <" + t.Name.Local + ">")
+		case xml.EndElement:
+			return sb.String(), nil
+		}
+	}
+}
+
+// value reads the object that starts with the start element.
+func (r *xmlReader) value(start xml.StartElement) (value, error) {
+	switch start.Name.Local {
+	case "string":
+		return r.text()
+	case "integer":
+		s, err := r.text()
+		if err != nil {
+			return nil, err
+		}
+		i, ok := parseInteger(strings.TrimSpace(s))
+		if !ok {
+			return nil, errXML("invalid integer " + strconv.Quote(s))
+		}
+		return i, nil
+	case "real":
+		s, err := r.text()
+		if err != nil {
+			return nil, err
+		}
+		f, ok := parseReal(strings.TrimSpace(s))
+		if !ok {
+			return nil, errXML("invalid real " + strconv.Quote(s))
+		}
+		return f, nil
+	case "true", "false":
+		if err := r.close(); err != nil {
+			return nil, err
+		}
+		return start.Name.Local == "true", nil
+	case "date":
+		s, err := r.text()
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(s))
+		if err != nil {
+			return nil, errXML("invalid date " + strconv.Quote(s))
+		}
+		return t.UTC(), nil
+	case "data":
+		s, err := r.text()
+		if err != nil {
+			return nil, err
+		}
+		s = strings.Map(func(c rune) rune {
+			if c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+				return -1
+			}
+			return c
+		}, s)
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, errXML("invalid data: " + err.Error())
+		}
+		return b, nil
+	case "array", "dict":
+		if r.depth >= maxDepth {
+			return nil, errXML("exceeded maximum nesting depth")
+		}
+		r.depth++
+		defer func() { r.depth-- }()
+		if start.Name.Local == "array" {
+			return r.array()
+		}
+		return r.dict()
+	}
+	return nil, errXML("unknown element <" + start.Name.Local + ">")
+}
+
+// array reads the values of an array.
+func (r *xmlReader) array() (value, error) {
+	values := array{}
+	for {
+		tok, err := r.token()
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			return values, nil
+		}
+		v, err := r.value(start)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+}
+
+// dict reads the keys and values of a dictionary. Dictionaries with a single
+// CF$UID key and an integer value are UIDs.
+func (r *xmlReader) dict() (value, error) {
+	d := &dict{}
+	for {
+		tok, err := r.token()
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			break
+		}
+		if start.Name.Local != "key" {
+			return nil, errXML("expected <key> in dictionary, got <" + start.Name.Local + ">")
+		}
+		k, err := r.text()
+		if err != nil {
+			return nil, err
+		}
+		start, err = r.start()
+		if err != nil {
+			return nil, err
+		}
+		v, err := r.value(start)
+		if err != nil {
+			return nil, err
+		}
+		d.keys = append(d.keys, k)
+		d.values = append(d.values, v)
+	}
+	if len(d.keys) == 1 && d.keys[0] == uidKey {
+		if i, ok := d.values[0].(integer); ok {
+			if u, ok := i.uint64(); ok {
+				return UID(u), nil
+			}
+		}
+	}
+	return d, nil
+}